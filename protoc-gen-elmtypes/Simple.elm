@@ -2,28 +2,220 @@
 module Simple exposing (..)
 import Json.Encode as JE
 import Json.Decode exposing (..)
+import Dict exposing (Dict)
 
-type SearchRequestCorpus = UNIVERSAL | WEB | IMAGES | LOCAL | NEWS | PRODUCTS | VIDEO
+type SearchRequestCorpus = SearchRequestCorpusUniversal
+    | SearchRequestCorpusWeb
+    | SearchRequestCorpusImages
+    | SearchRequestCorpusLocal
+    | SearchRequestCorpusNews
+    | SearchRequestCorpusProducts
+    | SearchRequestCorpusVideo
+    | SearchRequestCorpusUnrecognized String
 
 type alias SearchRequest = {
-  query: Maybe String,
-  page_number: Maybe Int,
-  result_per_page: Maybe Int,
-  corpus: Maybe SearchRequestCorpus
+  query: String,
+  page_number: Int,
+  result_per_page: Int,
+  corpus: SearchRequestCorpus
 }
 
 type alias SearchResponse = {
-  results: Maybe List String,
-  num_results: Maybe Int,
+  results: List String,
+  num_results: Int,
   original_request: Maybe SearchRequest
 }
 
 
+searchRequestCorpusFromString : String -> SearchRequestCorpus
+searchRequestCorpusFromString s =
+    case s of
+        "UNIVERSAL" ->
+            SearchRequestCorpusUniversal
 
-(maybe ("SearchRequestCorpus" := UNIVERSAL | WEB | IMAGES | LOCAL | NEWS | PRODUCTS | VIDEO))
+        "WEB" ->
+            SearchRequestCorpusWeb
 
-searchRequest = object4 SearchRequest (maybe ("query" := string)) (maybe ("page_number" := int)) (maybe ("result_per_page" := int)) (maybe ("corpus" := SearchRequestCorpus))
+        "IMAGES" ->
+            SearchRequestCorpusImages
 
-searchResponse = object3 SearchResponse (maybe ("results" := (list string))) (maybe ("num_results" := int)) (maybe ("original_request" := SearchRequest))
+        "LOCAL" ->
+            SearchRequestCorpusLocal
 
+        "NEWS" ->
+            SearchRequestCorpusNews
+
+        "PRODUCTS" ->
+            SearchRequestCorpusProducts
+
+        "VIDEO" ->
+            SearchRequestCorpusVideo
+
+        _ ->
+            SearchRequestCorpusUnrecognized s
+
+
+searchRequestCorpusToString : SearchRequestCorpus -> String
+searchRequestCorpusToString v =
+    case v of
+        SearchRequestCorpusUniversal ->
+            "UNIVERSAL"
+
+        SearchRequestCorpusWeb ->
+            "WEB"
+
+        SearchRequestCorpusImages ->
+            "IMAGES"
+
+        SearchRequestCorpusLocal ->
+            "LOCAL"
+
+        SearchRequestCorpusNews ->
+            "NEWS"
+
+        SearchRequestCorpusProducts ->
+            "PRODUCTS"
+
+        SearchRequestCorpusVideo ->
+            "VIDEO"
+
+        SearchRequestCorpusUnrecognized s ->
+            s
+
+
+searchRequestCorpusDecoder : Decoder SearchRequestCorpus
+searchRequestCorpusDecoder =
+    oneOf
+        [ map searchRequestCorpusFromString string
+        , int
+            |> map
+                (\n ->
+                    case n of
+                        0 ->
+                            SearchRequestCorpusUniversal
+
+                        1 ->
+                            SearchRequestCorpusWeb
+
+                        2 ->
+                            SearchRequestCorpusImages
+
+                        3 ->
+                            SearchRequestCorpusLocal
+
+                        4 ->
+                            SearchRequestCorpusNews
+
+                        5 ->
+                            SearchRequestCorpusProducts
+
+                        6 ->
+                            SearchRequestCorpusVideo
+
+                        _ ->
+                            SearchRequestCorpusUnrecognized (String.fromInt n)
+                )
+        ]
+
+
+searchRequestDecoder : Decoder SearchRequest
+searchRequestDecoder =
+    succeed SearchRequest
+        |> andMap (fieldWithDefault "query" "query" "" string)
+        |> andMap (fieldWithDefault "pageNumber" "page_number" 0 int)
+        |> andMap (fieldWithDefault "resultPerPage" "result_per_page" 0 int)
+        |> andMap (fieldWithDefault "corpus" "corpus" (searchRequestCorpusFromString "UNIVERSAL") searchRequestCorpusDecoder)
+
+
+searchResponseDecoder : Decoder SearchResponse
+searchResponseDecoder =
+    succeed SearchResponse
+        |> andMap (fieldWithDefault "results" "results" [] (list string))
+        |> andMap (fieldWithDefault "numResults" "num_results" 0 int)
+        |> andMap (optionalField "originalRequest" "original_request" (lazy (\_ -> searchRequestDecoder)))
+
+
+encodeSearchRequestCorpus : SearchRequestCorpus -> JE.Value
+encodeSearchRequestCorpus v =
+    JE.string (searchRequestCorpusToString v)
+
+
+encodeSearchRequest : SearchRequest -> JE.Value
+encodeSearchRequest v =
+    JE.object
+        (List.filterMap identity
+            [ Just ( "query", JE.string v.query )
+            , Just ( "pageNumber", JE.int v.page_number )
+            , Just ( "resultPerPage", JE.int v.result_per_page )
+            , Just ( "corpus", encodeSearchRequestCorpus v.corpus )
+            ]
+        )
+
+
+encodeSearchResponse : SearchResponse -> JE.Value
+encodeSearchResponse v =
+    JE.object
+        (List.filterMap identity
+            [ Just ( "results", (JE.list JE.string) v.results )
+            , Just ( "numResults", JE.int v.num_results )
+            , Maybe.map (\x -> ( "originalRequest", encodeSearchRequest x )) v.original_request
+            ]
+        )
+
+
+andMap : Decoder a -> Decoder (a -> b) -> Decoder b
+andMap =
+    map2 (|>)
+
+
+fieldWithDefault : String -> String -> a -> Decoder a -> Decoder a
+fieldWithDefault jsonName name default decoder =
+    optionalField jsonName name decoder
+        |> map (Maybe.withDefault default)
+
+
+int64 : Decoder String
+int64 =
+    oneOf [ string, map String.fromInt int ]
+
+
+oneOfFields : List (Decoder (Maybe a)) -> a -> Decoder a
+oneOfFields decoders notSet =
+    case decoders of
+        [] ->
+            succeed notSet
+
+        decoder :: rest ->
+            decoder
+                |> andThen
+                    (\member ->
+                        case member of
+                            Just m ->
+                                succeed m
+
+                            Nothing ->
+                                oneOfFields rest notSet
+                    )
+
+
+optionalField : String -> String -> Decoder a -> Decoder (Maybe a)
+optionalField jsonName name decoder =
+    value
+        |> andThen
+            (\json ->
+                case ( decodeValue (field jsonName value) json, decodeValue (field name value) json ) of
+                    ( Ok _, _ ) ->
+                        field jsonName (nullable decoder)
+
+                    ( _, Ok _ ) ->
+                        field name (nullable decoder)
+
+                    _ ->
+                        succeed Nothing
+            )
+
+
+requiredField : String -> String -> Decoder a -> Decoder a
+requiredField jsonName name decoder =
+    oneOf [ field jsonName decoder, field name decoder ]
 
@@ -0,0 +1,20 @@
+#!/bin/bash
+set -euo pipefail
+set -x
+
+cd testdata
+rm -fr output/*
+ds=(output/defaults output/always-qualify output/module-prefix)
+
+# GOPATH src root relative to the testdata directory
+GOPATH_ROOT="../../../../../"
+GOOGLEAPIS_ROOT="${GOPATH_ROOT}/github.com/googleapis/googleapis"
+
+mkdir -p ${ds[*]}
+# modules are written to paths following their names, so the outputs of all
+# files share the output directories
+for e in $(find . -name '*.proto' -not -path './output/*' | sed 's|^\./||' | sort); do
+    protoc -I. -I${GOPATH_ROOT} -I${GOOGLEAPIS_ROOT} --elmtypes_out=output/defaults/ "${e}"
+    protoc -I. -I${GOPATH_ROOT} -I${GOOGLEAPIS_ROOT} --elmtypes_out=always_qualify_type_names=true:output/always-qualify/ "${e}"
+    protoc -I. -I${GOPATH_ROOT} -I${GOOGLEAPIS_ROOT} --elmtypes_out=module_prefix=Proto:output/module-prefix/ "${e}"
+done
@@ -3,19 +3,22 @@ package genelmtypes
 import (
 	"bytes"
 	"fmt"
-	"log"
-	"path/filepath"
 	"strings"
 	"text/template"
 	"unicode"
 
-	"github.com/davecgh/go-spew/spew"
+	"github.com/golang/glog"
 	pbdescriptor "github.com/golang/protobuf/protoc-gen-go/descriptor"
 	"github.com/grpc-ecosystem/grpc-gateway/protoc-gen-grpc-gateway/descriptor"
 )
 
 type config struct {
 	alwaysQualifyTypeNames bool
+	modulePrefix           string
+	// file is the file being generated, whose module imports the types
+	// referenced from other files.
+	file    *descriptor.File
+	imports imports
 }
 
 func lowerFirst(s string) string {
@@ -27,18 +30,148 @@ func lowerFirst(s string) string {
 	return string(result)
 }
 
+func upperFirst(s string) string {
+	if len(s) == 0 {
+		return ""
+	}
+	result := []rune(s)
+	result[0] = unicode.ToUpper(result[0])
+	return string(result)
+}
+
 func isPrimitive(typeName string) bool {
 	return map[string]bool{
-		"String":  true,
-		"Int":     true,
-		"Float":   true,
-		"Boolean": true,
+		"String": true,
+		"Int":    true,
+		"Float":  true,
+		"Bool":   true,
 	}[typeName]
 }
 
+// parens wraps type applications such as `List String` for use as a type
+// argument.
+func parens(t string) string {
+	if strings.Contains(t, " ") && !strings.HasPrefix(t, "{") {
+		return fmt.Sprintf("(%s)", t)
+	}
+	return t
+}
+
+// reservedWords are the keywords of Elm, which cannot name record fields.
+var reservedWords = map[string]bool{
+	"type":     true,
+	"module":   true,
+	"port":     true,
+	"as":       true,
+	"case":     true,
+	"of":       true,
+	"if":       true,
+	"then":     true,
+	"else":     true,
+	"let":      true,
+	"in":       true,
+	"import":   true,
+	"exposing": true,
+	"where":    true,
+}
+
+// fieldName returns the record field holding the proto field name, which
+// has a "_" suffix if name is a reserved word.
+func fieldName(name string) string {
+	if reservedWords[name] {
+		return name + "_"
+	}
+	return name
+}
+
+// qualified applies f to name, keeping the alias of the module of imported
+// names, as in Shared.Invoice -> Shared.invoiceDecoder.
+func qualified(name string, f func(string) string) string {
+	i := strings.LastIndex(name, ".") + 1
+	return name[:i] + f(name[i:])
+}
+
+func decoderName(typeName string) string {
+	return qualified(typeName, func(s string) string { return lowerFirst(s) + "Decoder" })
+}
+
+func encoderName(typeName string) string {
+	return qualified(typeName, func(s string) string { return "encode" + s })
+}
+
+// fromStringName names the function converting value names to the enum
+// typeName.
+func fromStringName(typeName string) string {
+	return qualified(typeName, func(s string) string { return lowerFirst(s) + "FromString" })
+}
+
+// toStringName names the function converting the enum typeName to value
+// names.
+func toStringName(typeName string) string {
+	return qualified(typeName, func(s string) string { return lowerFirst(s) + "ToString" })
+}
+
+// decoderHelpers are declared in each generated module.
+const decoderHelpers = `andMap : Decoder a -> Decoder (a -> b) -> Decoder b
+andMap =
+    map2 (|>)
+
+
+fieldWithDefault : String -> String -> a -> Decoder a -> Decoder a
+fieldWithDefault jsonName name default decoder =
+    optionalField jsonName name decoder
+        |> map (Maybe.withDefault default)
+
+
+int64 : Decoder String
+int64 =
+    oneOf [ string, map String.fromInt int ]
+
+
+oneOfFields : List (Decoder (Maybe a)) -> a -> Decoder a
+oneOfFields decoders notSet =
+    case decoders of
+        [] ->
+            succeed notSet
+
+        decoder :: rest ->
+            decoder
+                |> andThen
+                    (\member ->
+                        case member of
+                            Just m ->
+                                succeed m
+
+                            Nothing ->
+                                oneOfFields rest notSet
+                    )
+
+
+optionalField : String -> String -> Decoder a -> Decoder (Maybe a)
+optionalField jsonName name decoder =
+    value
+        |> andThen
+            (\json ->
+                case ( decodeValue (field jsonName value) json, decodeValue (field name value) json ) of
+                    ( Ok _, _ ) ->
+                        field jsonName (nullable decoder)
+
+                    ( _, Ok _ ) ->
+                        field name (nullable decoder)
+
+                    _ ->
+                        succeed Nothing
+            )
+
+
+requiredField : String -> String -> Decoder a -> Decoder a
+requiredField jsonName name decoder =
+    oneOf [ field jsonName decoder, field name decoder ]`
+
 type ElmType interface {
 	ElmType() string
 	ElmTypeDecoder() string
+	ElmTypeEncoder() string
 	IsTypeAlias() bool
 }
 type NamedElmType interface {
@@ -53,43 +186,333 @@ func (s simpleElmType) ElmTypeDecoder() string {
 	if isPrimitive(string(s)) {
 		return strings.ToLower(string(s))
 	}
-	return string(s)
+	return decoderName(string(s))
+}
+func (s simpleElmType) ElmTypeEncoder() string {
+	if isPrimitive(string(s)) {
+		return "JE." + strings.ToLower(string(s))
+	}
+	return encoderName(string(s))
 }
 func (s simpleElmType) IsTypeAlias() bool { return false }
 
+// messageElmType is a reference to a message type. Its decoder is lazy as
+// messages may be recursive.
+type messageElmType string
+
+func (m messageElmType) ElmType() string { return string(m) }
+func (m messageElmType) ElmTypeDecoder() string {
+	return fmt.Sprintf("(lazy (\\_ -> %s))", decoderName(string(m)))
+}
+func (m messageElmType) ElmTypeEncoder() string { return encoderName(string(m)) }
+func (m messageElmType) IsTypeAlias() bool      { return false }
+
+// enumValue is a value of an enum and the constructor representing it.
+type enumValue struct {
+	Name        string
+	Number      int32
+	Constructor string
+}
+
+// enumElmType is an enum declaration. Values that are not known to the
+// generated code are represented by the Unrecognized constructor.
+type enumElmType struct {
+	Values       []enumValue
+	Unrecognized string
+}
+
+func (e *enumElmType) ElmType() string {
+	constructors := []string{}
+	for _, v := range e.Values {
+		constructors = append(constructors, v.Constructor)
+	}
+	constructors = append(constructors, e.Unrecognized+" String")
+	return strings.Join(constructors, "\n    | ")
+}
+func (e *enumElmType) ElmTypeDecoder() string { return "" }
+func (e *enumElmType) ElmTypeEncoder() string { return "" }
+func (e *enumElmType) IsTypeAlias() bool      { return false }
+
+// decoder declares conversions from and to the value names of the enum name
+// and its decoder, which accepts value names and numbers.
+func (e *enumElmType) decoder(name string) string {
+	fromString := fromStringName(name)
+	toString := toStringName(name)
+	fromCases, toCases, numberCases := []string{}, []string{}, []string{}
+	numbers := map[int32]bool{}
+	for _, v := range e.Values {
+		fromCases = append(fromCases, fmt.Sprintf("        \"%s\" ->\n            %s\n", v.Name, v.Constructor))
+		toCases = append(toCases, fmt.Sprintf("        %s ->\n            \"%s\"\n", v.Constructor, v.Name))
+		// aliases share numbers
+		if !numbers[v.Number] {
+			numbers[v.Number] = true
+			numberCases = append(numberCases, fmt.Sprintf("                        %d ->\n                            %s\n", v.Number, v.Constructor))
+		}
+	}
+	fromCases = append(fromCases, fmt.Sprintf("        _ ->\n            %s s\n", e.Unrecognized))
+	toCases = append(toCases, fmt.Sprintf("        %s s ->\n            s\n", e.Unrecognized))
+	numberCases = append(numberCases, fmt.Sprintf("                        _ ->\n                            %s (String.fromInt n)\n", e.Unrecognized))
+	return strings.Join([]string{
+		fmt.Sprintf("%s : String -> %s\n%s s =\n    case s of\n%s", fromString, name, fromString, strings.Join(fromCases, "\n")),
+		fmt.Sprintf("%s : %s -> String\n%s v =\n    case v of\n%s", toString, name, toString, strings.Join(toCases, "\n")),
+		fmt.Sprintf("%s : Decoder %s\n%s =\n    oneOf\n        [ map %s string\n        , int\n            |> map\n                (\\n ->\n                    case n of\n%s                )\n        ]",
+			decoderName(name), name, decoderName(name), fromString, strings.Join(numberCases, "\n")),
+	}, "\n\n")
+}
+
+// encoder declares the encoder of the enum name.
+func (e *enumElmType) encoder(name string) string {
+	return fmt.Sprintf("%s v =\n    JE.string (%s v)", encoderName(name), toStringName(name))
+}
+
+// elmCase converts an UPPER_SNAKE_CASE enum value name to CamelCase.
+func elmCase(s string) string {
+	parts := strings.Split(s, "_")
+	for i, p := range parts {
+		if p == "" {
+			continue
+		}
+		if p == strings.ToUpper(p) {
+			p = strings.ToLower(p)
+		}
+		parts[i] = strings.ToUpper(p[:1]) + p[1:]
+	}
+	return strings.Join(parts, "")
+}
+
+// enumConstructors names the constructors of the values of the enum name,
+// prefixed with the type name so that constructors of enums in one module do
+// not collide. Values prefixed with the enum name, as in COLOR_RED, drop the
+// prefix.
+func enumConstructors(name, enumName string, values []*pbdescriptor.EnumValueDescriptorProto) *enumElmType {
+	t := &enumElmType{}
+	taken := map[string]bool{}
+	constructor := func(s string) string {
+		for taken[s] {
+			s += "_"
+		}
+		taken[s] = true
+		return s
+	}
+	prefix := strings.ToUpper(toSnake(enumName)) + "_"
+	for _, v := range values {
+		valueName := v.GetName()
+		if strings.HasPrefix(valueName, prefix) && len(valueName) > len(prefix) {
+			valueName = valueName[len(prefix):]
+		}
+		t.Values = append(t.Values, enumValue{
+			Name:        v.GetName(),
+			Number:      v.GetNumber(),
+			Constructor: constructor(name + elmCase(valueName)),
+		})
+	}
+	t.Unrecognized = constructor(name + "Unrecognized")
+	return t
+}
+
+// toSnake converts a CamelCase name to snake_case.
+func toSnake(s string) string {
+	var b strings.Builder
+	for i, r := range s {
+		if unicode.IsUpper(r) && i > 0 {
+			b.WriteRune('_')
+		}
+		b.WriteRune(unicode.ToLower(r))
+	}
+	return b.String()
+}
+
+// codedElmType is a type with its own decoder and encoder, such as the
+// string representation of 64 bit integers.
+type codedElmType struct {
+	t       string
+	decoder string
+	encoder string
+}
+
+func (c codedElmType) ElmType() string        { return c.t }
+func (c codedElmType) ElmTypeDecoder() string { return c.decoder }
+func (c codedElmType) ElmTypeEncoder() string { return c.encoder }
+func (c codedElmType) IsTypeAlias() bool      { return false }
+
+var (
+	// int64ElmType is the string representation of 64 bit integers used
+	// by the proto3 JSON mapping, which also accepts numbers.
+	int64ElmType = codedElmType{"String", "int64", "JE.string"}
+	// valueElmType holds arbitrary JSON.
+	valueElmType = codedElmType{"JE.Value", "value", "identity"}
+)
+
+// knownTypes maps well-known types to the Elm types of their JSON mapping.
+var knownTypes = map[string]ElmType{
+	".google.protobuf.Timestamp":   simpleElmType("String"),
+	".google.protobuf.Duration":    simpleElmType("String"),
+	".google.protobuf.FieldMask":   simpleElmType("String"),
+	".google.protobuf.Struct":      valueElmType,
+	".google.protobuf.Value":       valueElmType,
+	".google.protobuf.ListValue":   valueElmType,
+	".google.protobuf.Any":         valueElmType,
+	".google.protobuf.NullValue":   codedElmType{"()", "(null ())", "(\\_ -> JE.null)"},
+	".google.protobuf.Empty":       codedElmType{"{}", "(succeed {})", "(\\_ -> JE.object [])"},
+	".google.protobuf.DoubleValue": simpleElmType("Float"),
+	".google.protobuf.FloatValue":  simpleElmType("Float"),
+	".google.protobuf.Int64Value":  int64ElmType,
+	".google.protobuf.UInt64Value": int64ElmType,
+	".google.protobuf.Int32Value":  simpleElmType("Int"),
+	".google.protobuf.UInt32Value": simpleElmType("Int"),
+	".google.protobuf.BoolValue":   simpleElmType("Bool"),
+	".google.protobuf.StringValue": simpleElmType("String"),
+	".google.protobuf.BytesValue":  simpleElmType("String"),
+}
+
+// mapElmType is a map field, which the JSON encoding renders as an object
+// keyed by the string form of the map keys.
+type mapElmType struct {
+	value ElmType
+}
+
+func (m mapElmType) ElmType() string { return fmt.Sprintf("Dict String %s", parens(m.value.ElmType())) }
+func (m mapElmType) ElmTypeDecoder() string {
+	return fmt.Sprintf("(dict %s)", m.value.ElmTypeDecoder())
+}
+func (m mapElmType) ElmTypeEncoder() string {
+	return fmt.Sprintf("(JE.dict identity %s)", m.value.ElmTypeEncoder())
+}
+func (m mapElmType) IsTypeAlias() bool { return false }
+
+// oneofElmType is a oneof declaration, with a constructor for each member
+// and NotSet for when no member is set.
+type oneofElmType struct {
+	Members      []NamedElmType
+	Constructors []string
+	NotSet       string
+}
+
+func (o *oneofElmType) ElmType() string {
+	constructors := []string{}
+	for i, m := range o.Members {
+		constructors = append(constructors, fmt.Sprintf("%s %s", o.Constructors[i], parens(m.ElmType())))
+	}
+	constructors = append(constructors, o.NotSet)
+	return strings.Join(constructors, "\n    | ")
+}
+func (o *oneofElmType) ElmTypeDecoder() string { return "" }
+func (o *oneofElmType) ElmTypeEncoder() string { return "" }
+func (o *oneofElmType) IsTypeAlias() bool      { return false }
+
+// decoder declares the decoder of the oneof name, which decodes the first
+// member set in the message object.
+func (o *oneofElmType) decoder(name string) string {
+	members := []string{}
+	for i, m := range o.Members {
+		members = append(members, fmt.Sprintf("map (Maybe.map %s) %s", o.Constructors[i], m.ElmTypeDecoder()))
+	}
+	return fmt.Sprintf("%s : Decoder %s\n%s =\n    oneOfFields\n        [ %s\n        ]\n        %s",
+		decoderName(name), name, decoderName(name), strings.Join(members, "\n        , "), o.NotSet)
+}
+
+// encoder declares the encoder of the oneof name, which returns the key value
+// pair of the member set.
+func (o *oneofElmType) encoder(name string) string {
+	cases := []string{}
+	for i, m := range o.Members {
+		f := m.(*namedElmType)
+		cases = append(cases, fmt.Sprintf("        %s x ->\n            Just ( \"%s\", %s x )\n", o.Constructors[i], f.JSONName, f.Type.ElmTypeEncoder()))
+	}
+	cases = append(cases, fmt.Sprintf("        %s ->\n            Nothing", o.NotSet))
+	return fmt.Sprintf("%s : %s -> Maybe ( String, JE.Value )\n%s v =\n    case v of\n%s",
+		encoderName(name), name, encoderName(name), strings.Join(cases, "\n"))
+}
+
+// oneofFieldElmType is the record field holding the oneof it names.
+type oneofFieldElmType string
+
+func (o oneofFieldElmType) ElmType() string        { return string(o) }
+func (o oneofFieldElmType) ElmTypeDecoder() string { return decoderName(string(o)) }
+func (o oneofFieldElmType) ElmTypeEncoder() string { return encoderName(string(o)) }
+func (o oneofFieldElmType) IsTypeAlias() bool      { return false }
+
 type repeatedElmType struct {
 	t ElmType
 }
 
-func (r repeatedElmType) ElmType() string { return fmt.Sprintf("List %s", r.t.ElmType()) }
+func (r repeatedElmType) ElmType() string { return fmt.Sprintf("List %s", parens(r.t.ElmType())) }
 func (r repeatedElmType) ElmTypeDecoder() string {
 	return fmt.Sprintf("(list %s)", r.t.ElmTypeDecoder())
 }
+func (r repeatedElmType) ElmTypeEncoder() string {
+	return fmt.Sprintf("(JE.list %s)", r.t.ElmTypeEncoder())
+}
 func (r repeatedElmType) IsTypeAlias() bool { return false }
 
 type namedElmType struct {
 	Name string
 	Type ElmType
+	// JSONName is the name of fields in the proto3 JSON mapping.
+	JSONName string
+	// Presence is how the record represents absent fields.
+	Presence presence
+	// zero is the value of absent fields with implicit presence, or "" if
+	// the type has no zero value.
+	zero string
 }
 
 func (t *namedElmType) ElmType() string {
 	return t.Type.ElmType()
-	return fmt.Sprintf("%s = %s", t.Name, t.Type.ElmType())
 }
+
+// ElmTypeDecoder returns the decoder declaration of messages and enums, and
+// the decoder of the value of fields.
 func (t *namedElmType) ElmTypeDecoder() string {
 	switch underlying := t.Type.(type) {
 	case *objectElmType:
-		nFields := len(underlying.Fields)
-		if nFields == 0 {
-			log.Println("0 fields")
-			return ""
-		}
-		return fmt.Sprintf("%s = object%d %s %s", lowerFirst(t.Name), nFields, t.Name, underlying.ElmTypeDecoder())
+		return fmt.Sprintf("%s : Decoder %s\n%s =\n%s", decoderName(t.Name), t.Name, decoderName(t.Name), underlying.decoder(t.Name))
+	case *enumElmType:
+		return underlying.decoder(t.Name)
+	case *oneofElmType:
+		return underlying.decoder(t.Name)
+	case oneofFieldElmType:
+		return underlying.ElmTypeDecoder()
+	}
+	switch t.Presence {
+	case implicitPresence:
+		return fmt.Sprintf("(fieldWithDefault \"%s\" \"%s\" %s %s)", t.JSONName, t.Name, t.zero, t.Type.ElmTypeDecoder())
+	case requiredPresence:
+		return fmt.Sprintf("(requiredField \"%s\" \"%s\" %s)", t.JSONName, t.Name, t.Type.ElmTypeDecoder())
+	default:
+		return fmt.Sprintf("(optionalField \"%s\" \"%s\" %s)", t.JSONName, t.Name, t.Type.ElmTypeDecoder())
+	}
+}
 
+// ElmTypeEncoder returns the encoder declaration of messages and enums, and
+// the encoding of the value of fields as a Maybe key value pair.
+func (t *namedElmType) ElmTypeEncoder() string {
+	switch underlying := t.Type.(type) {
+	case *objectElmType:
+		return fmt.Sprintf("%s : %s -> JE.Value\n%s", encoderName(t.Name), t.Name, underlying.encoder(encoderName(t.Name)))
+	case *enumElmType:
+		return fmt.Sprintf("%s : %s -> JE.Value\n%s", encoderName(t.Name), t.Name, underlying.encoder(t.Name))
+	case *oneofElmType:
+		return underlying.encoder(t.Name)
+	case oneofFieldElmType:
+		return fmt.Sprintf("%s v.%s", underlying.ElmTypeEncoder(), fieldName(t.Name))
+	}
+	switch t.Presence {
+	case implicitPresence, requiredPresence:
+		return fmt.Sprintf("Just ( \"%s\", %s v.%s )", t.JSONName, t.Type.ElmTypeEncoder(), fieldName(t.Name))
 	default:
-		return fmt.Sprintf("(maybe (\"%s\" := %s))", t.Name, t.Type.ElmTypeDecoder())
+		return fmt.Sprintf("Maybe.map (\\x -> ( \"%s\", %s x )) v.%s", t.JSONName, t.Type.ElmTypeEncoder(), fieldName(t.Name))
 	}
 }
+
+// isMaybe reports whether the record field t holds a Maybe value.
+func (t *namedElmType) isMaybe() bool {
+	if _, ok := t.Type.(oneofFieldElmType); ok {
+		return false
+	}
+	return t.Presence == explicitPresence
+}
+
 func (t *namedElmType) ElmTypeName() string {
 	return t.Name
 }
@@ -104,7 +527,11 @@ func (t objectElmType) IsTypeAlias() bool { return true }
 func (t *objectElmType) ElmType() string {
 	fields := []string{}
 	for _, f := range t.Fields {
-		fields = append(fields, fmt.Sprintf("  %s: Maybe %s", f.ElmTypeName(), f.ElmType()))
+		if !f.(*namedElmType).isMaybe() {
+			fields = append(fields, fmt.Sprintf("  %s: %s", fieldName(f.ElmTypeName()), f.ElmType()))
+			continue
+		}
+		fields = append(fields, fmt.Sprintf("  %s: Maybe %s", fieldName(f.ElmTypeName()), parens(f.ElmType())))
 	}
 	if len(fields) == 0 {
 		return fmt.Sprintf("{}")
@@ -120,6 +547,39 @@ func (t *objectElmType) ElmTypeDecoder() string {
 	return strings.Join(fields, " ")
 }
 
+func (t *objectElmType) ElmTypeEncoder() string {
+	fields := []string{}
+	for _, f := range t.Fields {
+		fields = append(fields, f.ElmTypeEncoder())
+	}
+	return strings.Join(fields, ", ")
+}
+
+// decoder applies the record constructor name to the field decoders.
+func (t *objectElmType) decoder(name string) string {
+	if len(t.Fields) == 0 {
+		return "    succeed {}"
+	}
+	lines := []string{fmt.Sprintf("    succeed %s", name)}
+	for _, f := range t.Fields {
+		lines = append(lines, fmt.Sprintf("        |> andMap %s", f.ElmTypeDecoder()))
+	}
+	return strings.Join(lines, "\n")
+}
+
+// encoder declares name, encoding the fields that are set.
+func (t *objectElmType) encoder(name string) string {
+	if len(t.Fields) == 0 {
+		return fmt.Sprintf("%s _ =\n    JE.object []", name)
+	}
+	fields := []string{}
+	for _, f := range t.Fields {
+		fields = append(fields, f.ElmTypeEncoder())
+	}
+	return fmt.Sprintf("%s v =\n    JE.object\n        (List.filterMap identity\n            [ %s\n            ]\n        )",
+		name, strings.Join(fields, "\n            , "))
+}
+
 func (cfg config) fqmnToType(fqmn string, registry *descriptor.Registry) (ElmType, error) {
 	m, err := registry.LookupMsg("", fqmn)
 	if err != nil {
@@ -131,116 +591,243 @@ func (cfg config) fqmnToType(fqmn string, registry *descriptor.Registry) (ElmTyp
 func (cfg config) fieldToType(f *descriptor.Field, reg *descriptor.Registry) (NamedElmType, error) {
 	// FieldMessage
 	var fieldType ElmType = simpleElmType("String")
+	zero := `""`
 	switch f.GetType() {
 	case pbdescriptor.FieldDescriptorProto_TYPE_DOUBLE:
 		fallthrough
 	case pbdescriptor.FieldDescriptorProto_TYPE_FLOAT:
 		fieldType = simpleElmType("Float")
-	case pbdescriptor.FieldDescriptorProto_TYPE_INT64:
-		fallthrough
-	case pbdescriptor.FieldDescriptorProto_TYPE_UINT64:
-		fallthrough
+		zero = "0"
 	case pbdescriptor.FieldDescriptorProto_TYPE_INT32:
 		fallthrough
-	case pbdescriptor.FieldDescriptorProto_TYPE_FIXED64:
-		fallthrough
 	case pbdescriptor.FieldDescriptorProto_TYPE_FIXED32:
 		fallthrough
 	case pbdescriptor.FieldDescriptorProto_TYPE_UINT32:
 		fallthrough
 	case pbdescriptor.FieldDescriptorProto_TYPE_SFIXED32:
 		fallthrough
-	case pbdescriptor.FieldDescriptorProto_TYPE_SFIXED64:
-		fallthrough
 	case pbdescriptor.FieldDescriptorProto_TYPE_SINT32:
+		fieldType = simpleElmType("Int")
+		zero = "0"
+	case pbdescriptor.FieldDescriptorProto_TYPE_INT64:
+		fallthrough
+	case pbdescriptor.FieldDescriptorProto_TYPE_UINT64:
+		fallthrough
+	case pbdescriptor.FieldDescriptorProto_TYPE_FIXED64:
+		fallthrough
+	case pbdescriptor.FieldDescriptorProto_TYPE_SFIXED64:
 		fallthrough
 	case pbdescriptor.FieldDescriptorProto_TYPE_SINT64:
-		fieldType = simpleElmType("Int")
+		fieldType = int64ElmType
+		zero = `"0"`
 	case pbdescriptor.FieldDescriptorProto_TYPE_BOOL:
-		fieldType = simpleElmType("Boolean")
+		fieldType = simpleElmType("Bool")
+		zero = "False"
 	case pbdescriptor.FieldDescriptorProto_TYPE_STRING:
 		fieldType = simpleElmType("String")
 	case pbdescriptor.FieldDescriptorProto_TYPE_GROUP:
-		fieldType = simpleElmType("?") // ?
+		// groups are messages declared with their field
+		fallthrough
 	case pbdescriptor.FieldDescriptorProto_TYPE_MESSAGE:
 		// TODO: should resolve type name relative to this type
 		ft, err := reg.LookupMsg("", f.GetTypeName())
 		if err != nil {
 			return nil, err
 		}
-		fieldType = simpleElmType(cfg.messageTypeName(ft))
+		if ft.GetOptions().GetMapEntry() {
+			value, err := cfg.fieldToType(ft.Fields[1], reg)
+			if err != nil {
+				return nil, err
+			}
+			return &namedElmType{Name: f.GetName(), Type: mapElmType{value.(*namedElmType).Type}, JSONName: f.GetJsonName(), zero: "Dict.empty"}, nil
+		}
+		zero = ""
+		if known, ok := knownTypes[ft.FQMN()]; ok {
+			fieldType = known
+		} else {
+			fieldType = messageElmType(cfg.messageTypeName(ft))
+		}
 	case pbdescriptor.FieldDescriptorProto_TYPE_BYTES:
-		fieldType = simpleElmType("string") // could be more correct
+		// base64 encoded
+		fieldType = simpleElmType("String")
 	case pbdescriptor.FieldDescriptorProto_TYPE_ENUM:
 		e, err := reg.LookupEnum("", f.GetTypeName())
 		if err != nil {
 			return nil, err
 		}
+		if known, ok := knownTypes[e.FQEN()]; ok {
+			// NullValue has the single value null
+			fieldType = known
+			zero = "()"
+			break
+		}
 
 		name := cfg.enumTypeName(e)
 		fieldType = simpleElmType(name)
+		zero = fmt.Sprintf("(%s \"%s\")", fromStringName(name), e.GetValue()[0].GetName())
 	default:
-		spew.Dump("default:", f.GetType())
-		fieldType = simpleElmType(fmt.Sprint(f.GetTypeName()))
+		glog.Warningf("%s: unsupported field type %s", f.GetName(), f.GetType())
+		fieldType = valueElmType
+		zero = ""
 	}
 	if f.GetLabel() == pbdescriptor.FieldDescriptorProto_LABEL_REPEATED {
 		fieldType = repeatedElmType{fieldType}
+		zero = "[]"
 	}
-	return &namedElmType{Name: f.GetName(), Type: fieldType}, nil
+	return &namedElmType{Name: f.GetName(), Type: fieldType, JSONName: f.GetJsonName(), zero: zero}, nil
 }
 
+// messageToElmType returns the record of m, in which each oneof is a field
+// placed at its first member.
 func (cfg config) messageToElmType(m *descriptor.Message, reg *descriptor.Registry) (ElmType, error) {
 	t := &objectElmType{Fields: []NamedElmType{}}
+	oneofs := map[int32]bool{}
 	for _, f := range m.Fields {
+		if isOneofMember(f) {
+			if !oneofs[f.GetOneofIndex()] {
+				oneofs[f.GetOneofIndex()] = true
+				oneof := m.GetOneofDecl()[f.GetOneofIndex()]
+				t.Fields = append(t.Fields, &namedElmType{
+					Name: oneof.GetName(),
+					Type: oneofFieldElmType(cfg.oneofTypeName(m, oneof)),
+				})
+			}
+			continue
+		}
 		field, err := cfg.fieldToType(f, reg)
 		if err != nil {
 			return nil, err
 		}
+		named := field.(*namedElmType)
+		named.Presence = fieldPresence(f)
+		if named.Presence == implicitPresence && named.zero == "" {
+			named.Presence = explicitPresence
+		}
 		t.Fields = append(t.Fields, field)
 	}
 	return &namedElmType{Name: cfg.messageTypeName(m), Type: t}, nil
 }
 
-func (cfg config) enumTypeName(e *descriptor.Enum) string {
-	name := strings.Replace(e.FQEN(), ".", "", -1)
-	if !cfg.alwaysQualifyTypeNames {
-		if strings.HasPrefix(name, e.File.GoPkg.Name) {
-			name = name[len(e.File.GoPkg.Name):]
+// oneofTypeName names the custom type of oneof after its message, with a
+// Oneof suffix so that it does not collide with a nested type named after the
+// oneof.
+func (cfg config) oneofTypeName(m *descriptor.Message, oneof *pbdescriptor.OneofDescriptorProto) string {
+	return cfg.messageTypeName(m) + elmCase(oneof.GetName()) + "Oneof"
+}
+
+// declaredNames returns the types and constructors declared by t.
+func declaredNames(t ElmType) []string {
+	named, ok := t.(*namedElmType)
+	if !ok {
+		return nil
+	}
+	names := []string{named.Name}
+	switch underlying := named.Type.(type) {
+	case *enumElmType:
+		for _, v := range underlying.Values {
+			names = append(names, v.Constructor)
 		}
+		names = append(names, underlying.Unrecognized)
+	case *oneofElmType:
+		names = append(names, underlying.Constructors...)
+		names = append(names, underlying.NotSet)
 	}
-	return name
+	return names
 }
 
-func (cfg config) messageTypeName(m *descriptor.Message) string {
-	name := strings.Replace(m.FQMN(), ".", "", -1)
-	if !cfg.alwaysQualifyTypeNames {
-		if strings.HasPrefix(name, m.File.GoPkg.Name) {
-			name = name[len(m.File.GoPkg.Name):]
+// oneofsToElmTypes returns the custom types of the oneofs of m.
+func (cfg config) oneofsToElmTypes(m *descriptor.Message, reg *descriptor.Registry) ([]ElmType, error) {
+	result := []ElmType{}
+	for i, oneof := range m.GetOneofDecl() {
+		members := []*descriptor.Field{}
+		for _, f := range m.Fields {
+			if isOneofMember(f) && int(f.GetOneofIndex()) == i {
+				members = append(members, f)
+			}
 		}
+		if len(members) == 0 {
+			// synthetic oneof of a proto3 optional field
+			continue
+		}
+		name := cfg.oneofTypeName(m, oneof)
+		t := &oneofElmType{}
+		taken := map[string]bool{}
+		constructor := func(s string) string {
+			for taken[s] {
+				s += "_"
+			}
+			taken[s] = true
+			return s
+		}
+		for _, f := range members {
+			field, err := cfg.fieldToType(f, reg)
+			if err != nil {
+				return nil, err
+			}
+			t.Members = append(t.Members, field)
+			t.Constructors = append(t.Constructors, constructor(name+elmCase(f.GetName())))
+		}
+		t.NotSet = constructor(name + "NotSet")
+		result = append(result, &namedElmType{Name: name, Type: t})
 	}
-	return name
+	return result, nil
 }
 
-func (cfg config) enumToElmType(e *descriptor.Enum, reg *descriptor.Registry) (ElmType, error) {
-	options := []string{}
-	for _, v := range e.Value {
-		options = append(options, fmt.Sprintf(`%s`, v.GetName()))
+// typeName names the type fqn declared in file after its outer types, as in
+// SearchRequestCorpus, prefixed with its package if alwaysQualifyTypeNames is
+// set.
+func (cfg config) typeName(file *descriptor.File, fqn string) string {
+	name := strings.TrimPrefix(fqn, ".")
+	segments := []string{}
+	if pkg := file.GetPackage(); pkg != "" {
+		name = strings.TrimPrefix(name, pkg+".")
+		if cfg.alwaysQualifyTypeNames {
+			for _, s := range strings.Split(pkg, ".") {
+				segments = append(segments, moduleSegment(s))
+			}
+		}
 	}
+	for _, s := range strings.Split(name, ".") {
+		segments = append(segments, upperFirst(s))
+	}
+	return strings.Join(segments, "")
+}
+
+// enumTypeName returns the name of e, qualified with the module it is
+// imported from when it is declared in another file.
+func (cfg config) enumTypeName(e *descriptor.Enum) string {
+	return cfg.use(e.File, cfg.typeName(e.File, e.FQEN()))
+}
+
+// messageTypeName returns the name of m, qualified with the module it is
+// imported from when it is declared in another file.
+func (cfg config) messageTypeName(m *descriptor.Message) string {
+	return cfg.use(m.File, cfg.typeName(m.File, m.FQMN()))
+}
+
+func (cfg config) enumToElmType(e *descriptor.Enum, reg *descriptor.Registry) (ElmType, error) {
 	name := cfg.enumTypeName(e)
 	return &namedElmType{
 		Name: name,
-		Type: simpleElmType(strings.Join(options, " | ")),
+		Type: enumConstructors(name, e.GetName(), e.GetValue()),
 	}, nil
 }
 
-func generateElmTypes(file *descriptor.File, registry *descriptor.Registry, qualifyTypes bool) (string, error) {
+func generateElmTypes(file *descriptor.File, registry *descriptor.Registry, opts GeneratorOptions) (string, error) {
 	result := []ElmType{}
 	f, err := registry.LookupFile(file.GetName())
 	if err != nil {
 		return "", err
 	}
+	imported, err := newImports(opts.ModulePrefix, f, registry)
+	if err != nil {
+		return "", err
+	}
 	cfg := config{
-		alwaysQualifyTypeNames: qualifyTypes,
+		alwaysQualifyTypeNames: opts.AlwaysQualifyTypes,
+		modulePrefix:           opts.ModulePrefix,
+		file:                   f,
+		imports:                imported,
 	}
 	for _, enum := range f.Enums {
 		t, err := cfg.enumToElmType(enum, registry)
@@ -250,11 +837,37 @@ func generateElmTypes(file *descriptor.File, registry *descriptor.Registry, qual
 		result = append(result, t)
 	}
 	for _, message := range f.Messages {
+		if message.GetOptions().GetMapEntry() {
+			// map fields are rendered as Dicts
+			continue
+		}
 		t, err := cfg.messageToElmType(message, registry)
 		if err != nil {
 			return "", err
 		}
 		result = append(result, t)
+		oneofs, err := cfg.oneofsToElmTypes(message, registry)
+		if err != nil {
+			return "", err
+		}
+		result = append(result, oneofs...)
+	}
+	declared := map[string]bool{}
+	for _, t := range result {
+		for _, name := range declaredNames(t) {
+			if declared[name] {
+				return "", fmt.Errorf("%s: %s is declared more than once", file.GetName(), name)
+			}
+			declared[name] = true
+		}
+	}
+	functions := []string{}
+	for _, s := range f.Services {
+		serviceFunctions, err := cfg.serviceToElm(s, registry)
+		if err != nil {
+			return "", err
+		}
+		functions = append(functions, serviceFunctions...)
 	}
 
 	buf := new(bytes.Buffer)
@@ -264,28 +877,54 @@ func generateElmTypes(file *descriptor.File, registry *descriptor.Registry, qual
 module {{.ModuleName}} exposing (..)
 import Json.Encode as JE
 import Json.Decode exposing (..)
+import Dict exposing (Dict)
+{{- if .Functions}}
+import Http
+{{- end}}
+{{- if .UsesURL}}
+import Url
+{{- end}}
+{{- if .UsesURLBuilder}}
+import Url.Builder
+{{- end}}
+{{- range .Imports}}
+{{.}}
+{{- end}}
 
 {{range .Types}}type {{if .IsTypeAlias}}alias {{end}}{{.ElmTypeName}} = {{.ElmType}}
 
 {{end}}
-
 {{range .Types}}{{.ElmTypeDecoder}}
 
-{{end}}
+
+{{end}}{{range .Types}}{{.ElmTypeEncoder}}
+
+
+{{end}}{{range .Functions}}{{.}}
+
+
+{{end}}{{.DecoderHelpers}}
 `)
 	if err != nil {
 		return "", err
 	}
 
-	name := file.GetName()
-	ext := filepath.Ext(name)
-	base := strings.TrimSuffix(name, ext)
 	err = tmpl.Execute(buf, struct {
-		ModuleName string
-		Types      []ElmType
+		ModuleName     string
+		Types          []ElmType
+		Functions      []string
+		UsesURL        bool
+		UsesURLBuilder bool
+		Imports        []string
+		DecoderHelpers string
 	}{
-		ModuleName: strings.Title(base),
-		Types:      result,
+		ModuleName:     moduleName(opts.ModulePrefix, file),
+		Types:          result,
+		Functions:      functions,
+		UsesURL:        strings.Contains(strings.Join(functions, "\n"), "Url.percentEncode"),
+		UsesURLBuilder: strings.Contains(strings.Join(functions, "\n"), "Url.Builder."),
+		Imports:        cfg.imports.lines(),
+		DecoderHelpers: decoderHelpers,
 	})
 	if err != nil {
 		return "", err
@@ -2,9 +2,6 @@ package genelmtypes
 
 import (
 	"errors"
-	"fmt"
-	"path/filepath"
-	"strings"
 
 	"github.com/golang/glog"
 	"github.com/golang/protobuf/proto"
@@ -25,11 +22,19 @@ func New(reg *descriptor.Registry) *generator {
 	return &generator{reg: reg}
 }
 
-func (g *generator) Generate(targets []*descriptor.File, qualifyTypes bool) ([]*plugin.CodeGeneratorResponse_File, error) {
+// GeneratorOptions describes output parameters
+type GeneratorOptions struct {
+	AlwaysQualifyTypes bool
+	// ModulePrefix is prepended to the module names derived from proto
+	// packages, as in Proto.Acme.Billing.Invoice.
+	ModulePrefix string
+}
+
+func (g *generator) Generate(targets []*descriptor.File, opts GeneratorOptions) ([]*plugin.CodeGeneratorResponse_File, error) {
 	var files []*plugin.CodeGeneratorResponse_File
 	for _, file := range targets {
 		glog.V(1).Infof("Processing %s", file.GetName())
-		code, err := generateElmTypes(file, g.reg, qualifyTypes)
+		code, err := generateElmTypes(file, g.reg, opts)
 		if err == errNoTargetService {
 			glog.V(1).Infof("%s: %v", file.GetName(), err)
 			continue
@@ -38,10 +43,7 @@ func (g *generator) Generate(targets []*descriptor.File, qualifyTypes bool) ([]*
 			return nil, err
 		}
 
-		name := file.GetName()
-		ext := filepath.Ext(name)
-		base := strings.TrimSuffix(name, ext)
-		output := fmt.Sprintf("%s.elm", strings.Title(base))
+		output := modulePath(moduleName(opts.ModulePrefix, file))
 		files = append(files, &plugin.CodeGeneratorResponse_File{
 			Name:    proto.String(output),
 			Content: proto.String(code),
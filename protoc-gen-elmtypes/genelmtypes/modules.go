@@ -0,0 +1,162 @@
+package genelmtypes
+
+import (
+	"fmt"
+	"path/filepath"
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/grpc-ecosystem/grpc-gateway/protoc-gen-grpc-gateway/descriptor"
+)
+
+// moduleSegment converts a package component or file name to a capitalized
+// Elm module name segment, as in invoice_service -> InvoiceService. Segments
+// not starting with a letter are prefixed with P.
+func moduleSegment(s string) string {
+	s = strings.Map(func(r rune) rune {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			return r
+		}
+		return '_'
+	}, s)
+	s = elmCase(s)
+	if s == "" || !unicode.IsLetter([]rune(s)[0]) {
+		s = "P" + s
+	}
+	return s
+}
+
+// moduleName names the module of file after the prefix, its package and its
+// base name, as in Proto.Acme.Billing.Invoice for acme/billing/invoice.proto
+// in the package acme.billing with the prefix Proto.
+func moduleName(prefix string, file *descriptor.File) string {
+	segments := []string{}
+	for _, s := range strings.Split(prefix+"."+file.GetPackage(), ".") {
+		if s != "" {
+			segments = append(segments, moduleSegment(s))
+		}
+	}
+	base := filepath.Base(file.GetName())
+	segments = append(segments, moduleSegment(strings.TrimSuffix(base, filepath.Ext(base))))
+	return strings.Join(segments, ".")
+}
+
+// modulePath returns the path of the module name, following the directory
+// layout Elm expects of source directories.
+func modulePath(name string) string {
+	return strings.Replace(name, ".", "/", -1) + ".elm"
+}
+
+// reservedAliases are the modules every generated module imports.
+var reservedAliases = map[string]bool{
+	"JE":   true,
+	"Dict": true,
+	"Http": true,
+	"Url":  true,
+}
+
+// imports maps the modules of the files a file depends on to the names they
+// are imported as, and records the modules used.
+type imports struct {
+	aliases map[string]string
+	used    map[string]bool
+}
+
+// newImports returns the imports of the modules whose types file may use,
+// which are those of its dependencies and of their public dependencies. Each
+// module is named after the last segment of its name, or as many of its last
+// segments as it takes to tell it apart from the other modules, as in Shared
+// for Proto.Acme.Shared.Shared.
+func newImports(prefix string, file *descriptor.File, reg *descriptor.Registry) (imports, error) {
+	modules := map[string]bool{}
+	var walk func(names []string) error
+	walk = func(names []string) error {
+		for _, name := range names {
+			dep, err := reg.LookupFile(name)
+			if err != nil {
+				return err
+			}
+			module := moduleName(prefix, dep)
+			if modules[module] {
+				continue
+			}
+			modules[module] = true
+			public := []string{}
+			for _, i := range dep.GetPublicDependency() {
+				public = append(public, dep.GetDependency()[i])
+			}
+			if err := walk(public); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	if err := walk(file.GetDependency()); err != nil {
+		return imports{}, err
+	}
+	delete(modules, moduleName(prefix, file))
+	i := imports{aliases: map[string]string{}, used: map[string]bool{}}
+	for module := range modules {
+		i.aliases[module] = moduleAlias(module, modules)
+	}
+	return i, nil
+}
+
+// moduleAlias returns the shortest run of the last segments of module that
+// no other module in modules ends with.
+func moduleAlias(module string, modules map[string]bool) string {
+	segments := strings.Split(module, ".")
+	alias := ""
+	for n := 1; n <= len(segments); n++ {
+		alias = strings.Join(segments[len(segments)-n:], "")
+		if reservedAliases[alias] {
+			continue
+		}
+		unique := true
+		for other := range modules {
+			s := strings.Split(other, ".")
+			if other != module && len(s) >= n && strings.Join(s[len(s)-n:], "") == alias {
+				unique = false
+				break
+			}
+		}
+		if unique {
+			return alias
+		}
+	}
+	for reservedAliases[alias] {
+		alias += "_"
+	}
+	return alias
+}
+
+// use returns name, declared in the module of file, qualified with the alias
+// of the module unless file is the file being generated.
+func (cfg config) use(file *descriptor.File, name string) string {
+	if cfg.file == nil || file.GetName() == cfg.file.GetName() {
+		return name
+	}
+	module := moduleName(cfg.modulePrefix, file)
+	alias, ok := cfg.imports.aliases[module]
+	if !ok {
+		alias = moduleAlias(module, map[string]bool{module: true})
+		cfg.imports.aliases[module] = alias
+	}
+	cfg.imports.used[module] = true
+	return alias + "." + name
+}
+
+// lines returns the import declarations of the modules used.
+func (i imports) lines() []string {
+	result := []string{}
+	for module := range i.used {
+		if alias := i.aliases[module]; alias != module {
+			result = append(result, fmt.Sprintf("import %s as %s", module, alias))
+		} else {
+			result = append(result, fmt.Sprintf("import %s", module))
+		}
+	}
+	sort.Strings(result)
+	return result
+}
@@ -0,0 +1,90 @@
+package genelmtypes
+
+import (
+	"github.com/gabriel/grpcutil/protoc-gen-tstypes/opts"
+	"github.com/golang/protobuf/proto"
+	pbdescriptor "github.com/golang/protobuf/protoc-gen-go/descriptor"
+	"github.com/grpc-ecosystem/grpc-gateway/protoc-gen-grpc-gateway/descriptor"
+	"google.golang.org/genproto/googleapis/api/annotations"
+)
+
+// presence is how a record field represents the absence of a field from
+// JSON objects.
+type presence int
+
+const (
+	// explicitPresence fields are Maybe values, Nothing when absent.
+	explicitPresence presence = iota
+	// implicitPresence fields take their zero value when absent, as proto3
+	// JSON omits scalars, lists and maps holding their zero values.
+	implicitPresence
+	// requiredPresence fields fail to decode when absent.
+	requiredPresence
+)
+
+// isProto3Optional reports whether f is a proto3 optional field, which protoc
+// declares as the single member of a synthetic oneof.
+func isProto3Optional(f *descriptor.Field) bool {
+	return f.GetProto3Optional()
+}
+
+// isOneofMember reports whether f is a member of a oneof, other than the
+// synthetic oneofs of proto3 optional fields.
+func isOneofMember(f *descriptor.Field) bool {
+	return f.OneofIndex != nil && !isProto3Optional(f)
+}
+
+// isRequired reports whether f is required by its label, the opts.field and
+// google.api.field_behavior annotations or the opts.field_defaults of its
+// message.
+func isRequired(f *descriptor.Field) bool {
+	if f.GetLabel() == pbdescriptor.FieldDescriptorProto_LABEL_REQUIRED {
+		return true
+	}
+	required := false
+	if m := f.Message.GetOptions(); m != nil {
+		if v, err := proto.GetExtension(m, opts.E_FieldDefaults); err == nil {
+			if o, ok := v.(*opts.Options); ok {
+				required = o.GetRequired() || o.GetFieldBehavior() == annotations.FieldBehavior_REQUIRED
+			}
+		}
+	}
+	if f.Options == nil {
+		return required
+	}
+	if v, err := proto.GetExtension(f.Options, opts.E_Field); err == nil {
+		if o, ok := v.(*opts.Options); ok {
+			required = o.GetRequired()
+		}
+	}
+	if v, err := proto.GetExtension(f.Options, annotations.E_FieldBehavior); err == nil {
+		if behaviors, ok := v.([]annotations.FieldBehavior); ok {
+			for _, b := range behaviors {
+				if b == annotations.FieldBehavior_REQUIRED {
+					required = true
+				}
+			}
+		}
+	}
+	return required
+}
+
+// fieldPresence returns the presence of f in the record of its message.
+// Repeated fields and proto3 scalars and enums that are not optional have
+// implicit presence, and other fields are Maybe values unless required.
+func fieldPresence(f *descriptor.Field) presence {
+	if f.GetLabel() == pbdescriptor.FieldDescriptorProto_LABEL_REPEATED {
+		return implicitPresence
+	}
+	switch f.GetType() {
+	case pbdescriptor.FieldDescriptorProto_TYPE_MESSAGE, pbdescriptor.FieldDescriptorProto_TYPE_GROUP:
+	default:
+		if f.Message.File.GetSyntax() == "proto3" && f.OneofIndex == nil {
+			return implicitPresence
+		}
+	}
+	if isRequired(f) {
+		return requiredPresence
+	}
+	return explicitPresence
+}
@@ -0,0 +1,297 @@
+package genelmtypes
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/golang/glog"
+	"github.com/grpc-ecosystem/grpc-gateway/protoc-gen-grpc-gateway/descriptor"
+)
+
+// pathVariable matches the variables of google.api.http path templates, such
+// as {name} or {name=shelves/*}.
+var pathVariable = regexp.MustCompile(`\{([^}=]+)(=[^}]*)?\}`)
+
+// methodFunctionName returns the name of the function calling m.
+func methodFunctionName(m *descriptor.Method) string {
+	return lowerFirst(m.Service.GetName()) + m.GetName()
+}
+
+// messageCoder returns the type, decoder and encoder of requests and
+// responses of type m.
+func (cfg config) messageCoder(m *descriptor.Message) ElmType {
+	if known, ok := knownTypes[m.FQMN()]; ok {
+		return known
+	}
+	return messageElmType(cfg.messageTypeName(m))
+}
+
+// isMaybeField reports whether f is held by a Maybe value in the record of
+// its message.
+func isMaybeField(f *descriptor.Field) bool {
+	return fieldPresence(f) == explicitPresence
+}
+
+// pathParameter returns an expression of the string value of the path
+// parameter p of request. Missing values are rendered as "".
+func (cfg config) pathParameter(p descriptor.Parameter, reg *descriptor.Registry) (string, error) {
+	expr := "request"
+	maybe := false
+	for _, c := range p.FieldPath {
+		if isOneofMember(c.Target) {
+			glog.Warningf("%s: path parameter %s in a oneof is not supported", p.Method.GetName(), p.FieldPath.String())
+			return `""`, nil
+		}
+		switch {
+		case !maybe:
+			expr = fmt.Sprintf("%s.%s", expr, fieldName(c.Target.GetName()))
+			maybe = isMaybeField(c.Target)
+		case isMaybeField(c.Target):
+			expr = fmt.Sprintf("%s |> Maybe.andThen .%s", expr, fieldName(c.Target.GetName()))
+		default:
+			expr = fmt.Sprintf("%s |> Maybe.map .%s", expr, fieldName(c.Target.GetName()))
+		}
+	}
+	field, err := cfg.fieldToType(p.Target, reg)
+	if err != nil {
+		return "", err
+	}
+	toString, ok := valueToString(field.(*namedElmType).Type)
+	if !ok {
+		glog.Warningf("%s: path parameter %s of type %s is not supported", p.Method.GetName(), p.FieldPath.String(), field.ElmType())
+		return `""`, nil
+	}
+	if !maybe {
+		if toString == "" {
+			return expr, nil
+		}
+		return fmt.Sprintf("(%s %s)", toString, expr), nil
+	}
+	if toString != "" {
+		expr = fmt.Sprintf("%s |> Maybe.map %s", expr, toString)
+	}
+	return fmt.Sprintf("(%s |> Maybe.withDefault \"\")", expr), nil
+}
+
+// valueToString returns the function converting values of t to the strings
+// of path and query parameters, which is "" for strings, or false if t has no
+// string form.
+func valueToString(t ElmType) (string, bool) {
+	switch t := t.(type) {
+	case simpleElmType:
+		switch t {
+		case "String":
+			return "", true
+		case "Int":
+			return "String.fromInt", true
+		case "Float":
+			return "String.fromFloat", true
+		case "Bool":
+			return `(\b -> if b then "true" else "false")`, true
+		}
+		// enums
+		return toStringName(string(t)), true
+	case codedElmType:
+		return "", t == int64ElmType
+	}
+	return "", false
+}
+
+// queryParameters returns the query parameters of the fields of the request
+// of b that are bound to neither its path nor its body, as grpc-gateway reads
+// them, or "" if there are none. Nested message fields are named by their
+// path, as in parent.child.
+func (cfg config) queryParameters(b *descriptor.Binding, reg *descriptor.Registry) (string, error) {
+	if b.Body != nil && len(b.Body.FieldPath) == 0 {
+		return "", nil
+	}
+	bound := map[string]bool{}
+	for _, p := range b.PathParams {
+		bound[p.FieldPath.String()] = true
+	}
+	if b.Body != nil {
+		bound[b.Body.FieldPath.String()] = true
+	}
+	params, err := cfg.messageQueryParameters(b.Method, b.Method.RequestType, "request", "", bound, map[string]bool{}, reg)
+	if err != nil || len(params) == 0 {
+		return "", err
+	}
+	return fmt.Sprintf("Url.Builder.toQuery (List.concat [ %s ])", strings.Join(params, ", ")), nil
+}
+
+// messageQueryParameters returns expressions of the lists of query
+// parameters of the fields of msg, whose value is the expression value.
+// Messages in seen are being expanded by an outer field, and are not expanded
+// again.
+func (cfg config) messageQueryParameters(m *descriptor.Method, msg *descriptor.Message, value, prefix string, bound, seen map[string]bool, reg *descriptor.Registry) ([]string, error) {
+	seen[msg.FQMN()] = true
+	defer delete(seen, msg.FQMN())
+	params := []string{}
+	for _, f := range msg.Fields {
+		name := prefix + f.GetName()
+		if bound[name] {
+			continue
+		}
+		if isOneofMember(f) {
+			glog.Warningf("%s: query parameter %s in a oneof is not supported", m.GetName(), name)
+			continue
+		}
+		field, err := cfg.fieldToType(f, reg)
+		if err != nil {
+			return nil, err
+		}
+		expr := fmt.Sprintf("%s.%s", value, fieldName(f.GetName()))
+		switch t := field.(*namedElmType).Type.(type) {
+		case messageElmType:
+			nestedMsg, err := reg.LookupMsg("", f.GetTypeName())
+			if err != nil {
+				return nil, err
+			}
+			if seen[nestedMsg.FQMN()] {
+				glog.Warningf("%s: query parameter %s of recursive type %s is not supported", m.GetName(), name, t)
+				continue
+			}
+			nested := fmt.Sprintf("nested%d", strings.Count(prefix, ".")+1)
+			nestedParams, err := cfg.messageQueryParameters(m, nestedMsg, nested, name+".", bound, seen, reg)
+			if err != nil {
+				return nil, err
+			}
+			if len(nestedParams) == 0 {
+				continue
+			}
+			list := fmt.Sprintf("(\\%s -> List.concat [ %s ])", nested, strings.Join(nestedParams, ", "))
+			if isMaybeField(f) {
+				params = append(params, fmt.Sprintf("(%s |> Maybe.map %s |> Maybe.withDefault [])", expr, list))
+			} else {
+				params = append(params, fmt.Sprintf("%s %s", list, expr))
+			}
+			continue
+		case repeatedElmType:
+			toString, ok := valueToString(t.t)
+			if !ok {
+				break
+			}
+			params = append(params, fmt.Sprintf("List.map %s %s", queryParameter(name, toString), expr))
+			continue
+		default:
+			toString, ok := valueToString(t)
+			if !ok {
+				break
+			}
+			if isMaybeField(f) {
+				params = append(params, fmt.Sprintf("(%s |> Maybe.map (List.singleton << %s) |> Maybe.withDefault [])", expr, queryParameter(name, toString)))
+			} else {
+				params = append(params, fmt.Sprintf("[ %s %s ]", queryParameter(name, toString), expr))
+			}
+			continue
+		}
+		glog.Warningf("%s: query parameter %s of type %s is not supported", m.GetName(), name, field.ElmType())
+	}
+	return params, nil
+}
+
+// queryParameter returns the function building the query parameter name of
+// values converted to strings by toString.
+func queryParameter(name, toString string) string {
+	if toString == "" {
+		return fmt.Sprintf("(Url.Builder.string \"%s\")", name)
+	}
+	return fmt.Sprintf("(Url.Builder.string \"%s\" << %s)", name, toString)
+}
+
+// methodRequest returns the method, url and body of the Http request of m,
+// following its first google.api.http binding, with the fields bound to
+// neither the path nor the body sent as query parameters, or the
+// /{package}.{Service}/{Method} route otherwise.
+func (cfg config) methodRequest(m *descriptor.Method, reg *descriptor.Registry) (string, string, string, error) {
+	request := cfg.messageCoder(m.RequestType)
+	if len(m.Bindings) == 0 {
+		return "POST", fmt.Sprintf("baseUrl ++ \"/%s/%s\"", strings.TrimPrefix(m.Service.FQSN(), "."), m.GetName()),
+			fmt.Sprintf("Http.jsonBody (%s request)", request.ElmTypeEncoder()), nil
+	}
+	b := m.Bindings[0]
+	params := map[string]descriptor.Parameter{}
+	for _, p := range b.PathParams {
+		params[p.FieldPath.String()] = p
+	}
+	var err error
+	url := pathVariable.ReplaceAllStringFunc(b.PathTmpl.Template, func(v string) string {
+		match := pathVariable.FindStringSubmatch(v)
+		p, ok := params[match[1]]
+		if !ok {
+			return v
+		}
+		value, perr := cfg.pathParameter(p, reg)
+		if perr != nil {
+			err = perr
+		}
+		// variables matching a single segment are escaped
+		if match[2] == "" || match[2] == "=*" {
+			value = fmt.Sprintf("Url.percentEncode %s", value)
+		}
+		return fmt.Sprintf("\" ++ %s ++ \"", value)
+	})
+	if err != nil {
+		return "", "", "", err
+	}
+	url = strings.TrimSuffix(fmt.Sprintf("baseUrl ++ \"%s\"", url), " ++ \"\"")
+	query, err := cfg.queryParameters(b, reg)
+	if err != nil {
+		return "", "", "", err
+	}
+	if query != "" {
+		url = fmt.Sprintf("%s ++ %s", url, query)
+	}
+
+	body := "Http.emptyBody"
+	switch {
+	case b.Body == nil:
+	case len(b.Body.FieldPath) == 0:
+		body = fmt.Sprintf("Http.jsonBody (%s request)", request.ElmTypeEncoder())
+	default:
+		field, err := cfg.fieldToType(b.Body.FieldPath[0].Target, reg)
+		if err != nil {
+			return "", "", "", err
+		}
+		encoder := field.(*namedElmType).Type.ElmTypeEncoder()
+		if isMaybeField(b.Body.FieldPath[0].Target) {
+			body = fmt.Sprintf("Http.jsonBody (request.%s |> Maybe.map %s |> Maybe.withDefault JE.null)", fieldName(field.ElmTypeName()), encoder)
+		} else {
+			body = fmt.Sprintf("Http.jsonBody (%s request.%s)", encoder, fieldName(field.ElmTypeName()))
+		}
+	}
+	return b.HTTPMethod, url, body, nil
+}
+
+// serviceToElm declares a function for each unary method of s, which sends
+// requests to baseUrl and decodes responses with the response decoder.
+// Streaming methods are skipped.
+func (cfg config) serviceToElm(s *descriptor.Service, reg *descriptor.Registry) ([]string, error) {
+	functions := []string{}
+	for _, m := range s.Methods {
+		if m.GetClientStreaming() || m.GetServerStreaming() {
+			glog.V(1).Infof("skipping streaming method %s", m.GetName())
+			continue
+		}
+		method, url, body, err := cfg.methodRequest(m, reg)
+		if err != nil {
+			return nil, err
+		}
+		request := cfg.messageCoder(m.RequestType)
+		response := cfg.messageCoder(m.ResponseType)
+		name := methodFunctionName(m)
+		functions = append(functions, fmt.Sprintf(`%s : String -> (Result Http.Error %s -> msg) -> %s -> Cmd msg
+%s baseUrl toMsg request =
+    Http.request
+        { method = "%s"
+        , headers = []
+        , url = %s
+        , body = %s
+        , expect = Http.expectJson toMsg %s
+        , timeout = Nothing
+        , tracker = Nothing
+        }`, name, parens(response.ElmType()), parens(request.ElmType()), name, method, url, body, response.ElmTypeDecoder()))
+	}
+	return functions, nil
+}
@@ -17,6 +17,7 @@ import (
 var (
 	importPrefix           = flag.String("import_prefix", "", "prefix to be added to go package paths for imported proto files")
 	flagAlwaysQualifyTypes = flag.Bool("always_qualify_type_names", false, "prefixes package names to all types if true")
+	flagModulePrefix       = flag.String("module_prefix", "", "prefix of the module names derived from proto packages, such as Proto")
 	file                   = flag.String("file", "stdin", "where to load data from")
 )
 
@@ -88,7 +89,10 @@ func main() {
 		targets = append(targets, f)
 	}
 
-	out, err := g.Generate(targets, *flagAlwaysQualifyTypes)
+	out, err := g.Generate(targets, genelmtypes.GeneratorOptions{
+		AlwaysQualifyTypes: *flagAlwaysQualifyTypes,
+		ModulePrefix:       *flagModulePrefix,
+	})
 	glog.V(1).Info("Processed code generator request")
 	if err != nil {
 		emitError(err)
@@ -98,7 +102,12 @@ func main() {
 }
 
 func emitFiles(out []*plugin.CodeGeneratorResponse_File) {
-	emitResp(&plugin.CodeGeneratorResponse{File: out})
+	emitResp(&plugin.CodeGeneratorResponse{
+		File: out,
+		// protoc only runs plugins supporting proto3 optional fields on
+		// files declaring them
+		SupportedFeatures: proto.Uint64(uint64(plugin.CodeGeneratorResponse_FEATURE_PROTO3_OPTIONAL)),
+	})
 }
 
 func emitError(err error) {
@@ -0,0 +1,142 @@
+-- this is a generated file
+module Acme.Billing.Invoice exposing (..)
+import Json.Encode as JE
+import Json.Decode exposing (..)
+import Dict exposing (Dict)
+import Http
+import Url
+import Url.Builder
+import Acme.Shared.Shared as Shared
+
+type alias AcmeBillingInvoice = {
+  invoice: Maybe Shared.AcmeSharedInvoice,
+  currency: Shared.AcmeSharedCurrency,
+  payments: List Shared.AcmeSharedMoney,
+  adjustments: Dict String Shared.AcmeSharedMoney
+}
+
+type alias AcmeBillingGetInvoiceRequest = {
+  id: String,
+  currency: Shared.AcmeSharedCurrency
+}
+
+
+acmeBillingInvoiceDecoder : Decoder AcmeBillingInvoice
+acmeBillingInvoiceDecoder =
+    succeed AcmeBillingInvoice
+        |> andMap (optionalField "invoice" "invoice" (lazy (\_ -> Shared.acmeSharedInvoiceDecoder)))
+        |> andMap (fieldWithDefault "currency" "currency" (Shared.acmeSharedCurrencyFromString "CURRENCY_UNSPECIFIED") Shared.acmeSharedCurrencyDecoder)
+        |> andMap (fieldWithDefault "payments" "payments" [] (list (lazy (\_ -> Shared.acmeSharedMoneyDecoder))))
+        |> andMap (fieldWithDefault "adjustments" "adjustments" Dict.empty (dict (lazy (\_ -> Shared.acmeSharedMoneyDecoder))))
+
+
+acmeBillingGetInvoiceRequestDecoder : Decoder AcmeBillingGetInvoiceRequest
+acmeBillingGetInvoiceRequestDecoder =
+    succeed AcmeBillingGetInvoiceRequest
+        |> andMap (fieldWithDefault "id" "id" "" string)
+        |> andMap (fieldWithDefault "currency" "currency" (Shared.acmeSharedCurrencyFromString "CURRENCY_UNSPECIFIED") Shared.acmeSharedCurrencyDecoder)
+
+
+encodeAcmeBillingInvoice : AcmeBillingInvoice -> JE.Value
+encodeAcmeBillingInvoice v =
+    JE.object
+        (List.filterMap identity
+            [ Maybe.map (\x -> ( "invoice", Shared.encodeAcmeSharedInvoice x )) v.invoice
+            , Just ( "currency", Shared.encodeAcmeSharedCurrency v.currency )
+            , Just ( "payments", (JE.list Shared.encodeAcmeSharedMoney) v.payments )
+            , Just ( "adjustments", (JE.dict identity Shared.encodeAcmeSharedMoney) v.adjustments )
+            ]
+        )
+
+
+encodeAcmeBillingGetInvoiceRequest : AcmeBillingGetInvoiceRequest -> JE.Value
+encodeAcmeBillingGetInvoiceRequest v =
+    JE.object
+        (List.filterMap identity
+            [ Just ( "id", JE.string v.id )
+            , Just ( "currency", Shared.encodeAcmeSharedCurrency v.currency )
+            ]
+        )
+
+
+billingGetInvoice : String -> (Result Http.Error Shared.AcmeSharedInvoice -> msg) -> AcmeBillingGetInvoiceRequest -> Cmd msg
+billingGetInvoice baseUrl toMsg request =
+    Http.request
+        { method = "GET"
+        , headers = []
+        , url = baseUrl ++ "/v1/invoices/" ++ Url.percentEncode request.id ++ Url.Builder.toQuery (List.concat [ [ (Url.Builder.string "currency" << Shared.acmeSharedCurrencyToString) request.currency ] ])
+        , body = Http.emptyBody
+        , expect = Http.expectJson toMsg (lazy (\_ -> Shared.acmeSharedInvoiceDecoder))
+        , timeout = Nothing
+        , tracker = Nothing
+        }
+
+
+billingCreateInvoice : String -> (Result Http.Error AcmeBillingInvoice -> msg) -> Shared.AcmeSharedInvoice -> Cmd msg
+billingCreateInvoice baseUrl toMsg request =
+    Http.request
+        { method = "POST"
+        , headers = []
+        , url = baseUrl ++ "/v1/invoices"
+        , body = Http.jsonBody (Shared.encodeAcmeSharedInvoice request)
+        , expect = Http.expectJson toMsg (lazy (\_ -> acmeBillingInvoiceDecoder))
+        , timeout = Nothing
+        , tracker = Nothing
+        }
+
+
+andMap : Decoder a -> Decoder (a -> b) -> Decoder b
+andMap =
+    map2 (|>)
+
+
+fieldWithDefault : String -> String -> a -> Decoder a -> Decoder a
+fieldWithDefault jsonName name default decoder =
+    optionalField jsonName name decoder
+        |> map (Maybe.withDefault default)
+
+
+int64 : Decoder String
+int64 =
+    oneOf [ string, map String.fromInt int ]
+
+
+oneOfFields : List (Decoder (Maybe a)) -> a -> Decoder a
+oneOfFields decoders notSet =
+    case decoders of
+        [] ->
+            succeed notSet
+
+        decoder :: rest ->
+            decoder
+                |> andThen
+                    (\member ->
+                        case member of
+                            Just m ->
+                                succeed m
+
+                            Nothing ->
+                                oneOfFields rest notSet
+                    )
+
+
+optionalField : String -> String -> Decoder a -> Decoder (Maybe a)
+optionalField jsonName name decoder =
+    value
+        |> andThen
+            (\json ->
+                case ( decodeValue (field jsonName value) json, decodeValue (field name value) json ) of
+                    ( Ok _, _ ) ->
+                        field jsonName (nullable decoder)
+
+                    ( _, Ok _ ) ->
+                        field name (nullable decoder)
+
+                    _ ->
+                        succeed Nothing
+            )
+
+
+requiredField : String -> String -> Decoder a -> Decoder a
+requiredField jsonName name decoder =
+    oneOf [ field jsonName decoder, field name decoder ]
@@ -0,0 +1,171 @@
+-- this is a generated file
+module Acme.Shared.Shared exposing (..)
+import Json.Encode as JE
+import Json.Decode exposing (..)
+import Dict exposing (Dict)
+
+type AcmeSharedCurrency = AcmeSharedCurrencyUnspecified
+    | AcmeSharedCurrencyEur
+    | AcmeSharedCurrencyUsd
+    | AcmeSharedCurrencyUnrecognized String
+
+type alias AcmeSharedMoney = {
+  currency: AcmeSharedCurrency,
+  units: String
+}
+
+type alias AcmeSharedInvoice = {
+  id: String,
+  total: Maybe AcmeSharedMoney
+}
+
+
+acmeSharedCurrencyFromString : String -> AcmeSharedCurrency
+acmeSharedCurrencyFromString s =
+    case s of
+        "CURRENCY_UNSPECIFIED" ->
+            AcmeSharedCurrencyUnspecified
+
+        "CURRENCY_EUR" ->
+            AcmeSharedCurrencyEur
+
+        "CURRENCY_USD" ->
+            AcmeSharedCurrencyUsd
+
+        _ ->
+            AcmeSharedCurrencyUnrecognized s
+
+
+acmeSharedCurrencyToString : AcmeSharedCurrency -> String
+acmeSharedCurrencyToString v =
+    case v of
+        AcmeSharedCurrencyUnspecified ->
+            "CURRENCY_UNSPECIFIED"
+
+        AcmeSharedCurrencyEur ->
+            "CURRENCY_EUR"
+
+        AcmeSharedCurrencyUsd ->
+            "CURRENCY_USD"
+
+        AcmeSharedCurrencyUnrecognized s ->
+            s
+
+
+acmeSharedCurrencyDecoder : Decoder AcmeSharedCurrency
+acmeSharedCurrencyDecoder =
+    oneOf
+        [ map acmeSharedCurrencyFromString string
+        , int
+            |> map
+                (\n ->
+                    case n of
+                        0 ->
+                            AcmeSharedCurrencyUnspecified
+
+                        1 ->
+                            AcmeSharedCurrencyEur
+
+                        2 ->
+                            AcmeSharedCurrencyUsd
+
+                        _ ->
+                            AcmeSharedCurrencyUnrecognized (String.fromInt n)
+                )
+        ]
+
+
+acmeSharedMoneyDecoder : Decoder AcmeSharedMoney
+acmeSharedMoneyDecoder =
+    succeed AcmeSharedMoney
+        |> andMap (fieldWithDefault "currency" "currency" (acmeSharedCurrencyFromString "CURRENCY_UNSPECIFIED") acmeSharedCurrencyDecoder)
+        |> andMap (fieldWithDefault "units" "units" "0" int64)
+
+
+acmeSharedInvoiceDecoder : Decoder AcmeSharedInvoice
+acmeSharedInvoiceDecoder =
+    succeed AcmeSharedInvoice
+        |> andMap (fieldWithDefault "id" "id" "" string)
+        |> andMap (optionalField "total" "total" (lazy (\_ -> acmeSharedMoneyDecoder)))
+
+
+encodeAcmeSharedCurrency : AcmeSharedCurrency -> JE.Value
+encodeAcmeSharedCurrency v =
+    JE.string (acmeSharedCurrencyToString v)
+
+
+encodeAcmeSharedMoney : AcmeSharedMoney -> JE.Value
+encodeAcmeSharedMoney v =
+    JE.object
+        (List.filterMap identity
+            [ Just ( "currency", encodeAcmeSharedCurrency v.currency )
+            , Just ( "units", JE.string v.units )
+            ]
+        )
+
+
+encodeAcmeSharedInvoice : AcmeSharedInvoice -> JE.Value
+encodeAcmeSharedInvoice v =
+    JE.object
+        (List.filterMap identity
+            [ Just ( "id", JE.string v.id )
+            , Maybe.map (\x -> ( "total", encodeAcmeSharedMoney x )) v.total
+            ]
+        )
+
+
+andMap : Decoder a -> Decoder (a -> b) -> Decoder b
+andMap =
+    map2 (|>)
+
+
+fieldWithDefault : String -> String -> a -> Decoder a -> Decoder a
+fieldWithDefault jsonName name default decoder =
+    optionalField jsonName name decoder
+        |> map (Maybe.withDefault default)
+
+
+int64 : Decoder String
+int64 =
+    oneOf [ string, map String.fromInt int ]
+
+
+oneOfFields : List (Decoder (Maybe a)) -> a -> Decoder a
+oneOfFields decoders notSet =
+    case decoders of
+        [] ->
+            succeed notSet
+
+        decoder :: rest ->
+            decoder
+                |> andThen
+                    (\member ->
+                        case member of
+                            Just m ->
+                                succeed m
+
+                            Nothing ->
+                                oneOfFields rest notSet
+                    )
+
+
+optionalField : String -> String -> Decoder a -> Decoder (Maybe a)
+optionalField jsonName name decoder =
+    value
+        |> andThen
+            (\json ->
+                case ( decodeValue (field jsonName value) json, decodeValue (field name value) json ) of
+                    ( Ok _, _ ) ->
+                        field jsonName (nullable decoder)
+
+                    ( _, Ok _ ) ->
+                        field name (nullable decoder)
+
+                    _ ->
+                        succeed Nothing
+            )
+
+
+requiredField : String -> String -> Decoder a -> Decoder a
+requiredField jsonName name decoder =
+    oneOf [ field jsonName decoder, field name decoder ]
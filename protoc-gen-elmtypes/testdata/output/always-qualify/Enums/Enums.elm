@@ -0,0 +1,292 @@
+-- this is a generated file
+module Enums.Enums exposing (..)
+import Json.Encode as JE
+import Json.Decode exposing (..)
+import Dict exposing (Dict)
+
+type EnumsTaskKind = EnumsTaskKindUnspecified
+    | EnumsTaskKindBug
+    | EnumsTaskKindFeature
+    | EnumsTaskKindUnrecognized String
+
+type EnumsColor = EnumsColorUnspecified
+    | EnumsColorRed
+    | EnumsColorGreen
+    | EnumsColorUnrecognized String
+
+type EnumsStatus = EnumsStatusUnknown
+    | EnumsStatusStarted
+    | EnumsStatusRunning
+    | EnumsStatusDone
+    | EnumsStatusUnrecognized String
+
+type alias EnumsTask = {
+  kind: EnumsTaskKind,
+  color: EnumsColor,
+  status: EnumsStatus,
+  labels: List EnumsColor
+}
+
+
+enumsTaskKindFromString : String -> EnumsTaskKind
+enumsTaskKindFromString s =
+    case s of
+        "KIND_UNSPECIFIED" ->
+            EnumsTaskKindUnspecified
+
+        "BUG" ->
+            EnumsTaskKindBug
+
+        "FEATURE" ->
+            EnumsTaskKindFeature
+
+        _ ->
+            EnumsTaskKindUnrecognized s
+
+
+enumsTaskKindToString : EnumsTaskKind -> String
+enumsTaskKindToString v =
+    case v of
+        EnumsTaskKindUnspecified ->
+            "KIND_UNSPECIFIED"
+
+        EnumsTaskKindBug ->
+            "BUG"
+
+        EnumsTaskKindFeature ->
+            "FEATURE"
+
+        EnumsTaskKindUnrecognized s ->
+            s
+
+
+enumsTaskKindDecoder : Decoder EnumsTaskKind
+enumsTaskKindDecoder =
+    oneOf
+        [ map enumsTaskKindFromString string
+        , int
+            |> map
+                (\n ->
+                    case n of
+                        0 ->
+                            EnumsTaskKindUnspecified
+
+                        1 ->
+                            EnumsTaskKindBug
+
+                        2 ->
+                            EnumsTaskKindFeature
+
+                        _ ->
+                            EnumsTaskKindUnrecognized (String.fromInt n)
+                )
+        ]
+
+
+enumsColorFromString : String -> EnumsColor
+enumsColorFromString s =
+    case s of
+        "COLOR_UNSPECIFIED" ->
+            EnumsColorUnspecified
+
+        "COLOR_RED" ->
+            EnumsColorRed
+
+        "COLOR_GREEN" ->
+            EnumsColorGreen
+
+        _ ->
+            EnumsColorUnrecognized s
+
+
+enumsColorToString : EnumsColor -> String
+enumsColorToString v =
+    case v of
+        EnumsColorUnspecified ->
+            "COLOR_UNSPECIFIED"
+
+        EnumsColorRed ->
+            "COLOR_RED"
+
+        EnumsColorGreen ->
+            "COLOR_GREEN"
+
+        EnumsColorUnrecognized s ->
+            s
+
+
+enumsColorDecoder : Decoder EnumsColor
+enumsColorDecoder =
+    oneOf
+        [ map enumsColorFromString string
+        , int
+            |> map
+                (\n ->
+                    case n of
+                        0 ->
+                            EnumsColorUnspecified
+
+                        1 ->
+                            EnumsColorRed
+
+                        2 ->
+                            EnumsColorGreen
+
+                        _ ->
+                            EnumsColorUnrecognized (String.fromInt n)
+                )
+        ]
+
+
+enumsStatusFromString : String -> EnumsStatus
+enumsStatusFromString s =
+    case s of
+        "UNKNOWN" ->
+            EnumsStatusUnknown
+
+        "STARTED" ->
+            EnumsStatusStarted
+
+        "RUNNING" ->
+            EnumsStatusRunning
+
+        "DONE" ->
+            EnumsStatusDone
+
+        _ ->
+            EnumsStatusUnrecognized s
+
+
+enumsStatusToString : EnumsStatus -> String
+enumsStatusToString v =
+    case v of
+        EnumsStatusUnknown ->
+            "UNKNOWN"
+
+        EnumsStatusStarted ->
+            "STARTED"
+
+        EnumsStatusRunning ->
+            "RUNNING"
+
+        EnumsStatusDone ->
+            "DONE"
+
+        EnumsStatusUnrecognized s ->
+            s
+
+
+enumsStatusDecoder : Decoder EnumsStatus
+enumsStatusDecoder =
+    oneOf
+        [ map enumsStatusFromString string
+        , int
+            |> map
+                (\n ->
+                    case n of
+                        0 ->
+                            EnumsStatusUnknown
+
+                        1 ->
+                            EnumsStatusStarted
+
+                        2 ->
+                            EnumsStatusDone
+
+                        _ ->
+                            EnumsStatusUnrecognized (String.fromInt n)
+                )
+        ]
+
+
+enumsTaskDecoder : Decoder EnumsTask
+enumsTaskDecoder =
+    succeed EnumsTask
+        |> andMap (fieldWithDefault "kind" "kind" (enumsTaskKindFromString "KIND_UNSPECIFIED") enumsTaskKindDecoder)
+        |> andMap (fieldWithDefault "color" "color" (enumsColorFromString "COLOR_UNSPECIFIED") enumsColorDecoder)
+        |> andMap (fieldWithDefault "status" "status" (enumsStatusFromString "UNKNOWN") enumsStatusDecoder)
+        |> andMap (fieldWithDefault "labels" "labels" [] (list enumsColorDecoder))
+
+
+encodeEnumsTaskKind : EnumsTaskKind -> JE.Value
+encodeEnumsTaskKind v =
+    JE.string (enumsTaskKindToString v)
+
+
+encodeEnumsColor : EnumsColor -> JE.Value
+encodeEnumsColor v =
+    JE.string (enumsColorToString v)
+
+
+encodeEnumsStatus : EnumsStatus -> JE.Value
+encodeEnumsStatus v =
+    JE.string (enumsStatusToString v)
+
+
+encodeEnumsTask : EnumsTask -> JE.Value
+encodeEnumsTask v =
+    JE.object
+        (List.filterMap identity
+            [ Just ( "kind", encodeEnumsTaskKind v.kind )
+            , Just ( "color", encodeEnumsColor v.color )
+            , Just ( "status", encodeEnumsStatus v.status )
+            , Just ( "labels", (JE.list encodeEnumsColor) v.labels )
+            ]
+        )
+
+
+andMap : Decoder a -> Decoder (a -> b) -> Decoder b
+andMap =
+    map2 (|>)
+
+
+fieldWithDefault : String -> String -> a -> Decoder a -> Decoder a
+fieldWithDefault jsonName name default decoder =
+    optionalField jsonName name decoder
+        |> map (Maybe.withDefault default)
+
+
+int64 : Decoder String
+int64 =
+    oneOf [ string, map String.fromInt int ]
+
+
+oneOfFields : List (Decoder (Maybe a)) -> a -> Decoder a
+oneOfFields decoders notSet =
+    case decoders of
+        [] ->
+            succeed notSet
+
+        decoder :: rest ->
+            decoder
+                |> andThen
+                    (\member ->
+                        case member of
+                            Just m ->
+                                succeed m
+
+                            Nothing ->
+                                oneOfFields rest notSet
+                    )
+
+
+optionalField : String -> String -> Decoder a -> Decoder (Maybe a)
+optionalField jsonName name decoder =
+    value
+        |> andThen
+            (\json ->
+                case ( decodeValue (field jsonName value) json, decodeValue (field name value) json ) of
+                    ( Ok _, _ ) ->
+                        field jsonName (nullable decoder)
+
+                    ( _, Ok _ ) ->
+                        field name (nullable decoder)
+
+                    _ ->
+                        succeed Nothing
+            )
+
+
+requiredField : String -> String -> Decoder a -> Decoder a
+requiredField jsonName name decoder =
+    oneOf [ field jsonName decoder, field name decoder ]
@@ -0,0 +1,418 @@
+-- this is a generated file
+module Library.Library exposing (..)
+import Json.Encode as JE
+import Json.Decode exposing (..)
+import Dict exposing (Dict)
+import Http
+import Url
+import Url.Builder
+
+type LibraryGenre = LibraryGenreUnspecified
+    | LibraryGenreFiction
+    | LibraryGenreHistory
+    | LibraryGenreUnrecognized String
+
+type alias LibraryBook = {
+  name: String,
+  title: String,
+  genre: LibraryGenre,
+  authors: List String
+}
+
+type alias LibraryFilter = {
+  title: String,
+  genres: List LibraryGenre,
+  available: Maybe Bool,
+  published_after: Maybe String
+}
+
+type alias LibraryGetBookRequest = {
+  name: String
+}
+
+type alias LibraryListBooksRequest = {
+  shelf: Int,
+  page_size: Int,
+  page_token: String,
+  filter: Maybe LibraryFilter,
+  labels: Dict String String
+}
+
+type alias LibraryListBooksResponse = {
+  books: List LibraryBook,
+  next_page_token: String
+}
+
+type alias LibraryCreateBookRequest = {
+  shelf: Int,
+  book: Maybe LibraryBook,
+  request_id: String
+}
+
+type alias LibraryDeleteBookRequest = {
+  name: String,
+  force: Bool
+}
+
+type alias LibraryMoveBookRequest = {
+  name: String,
+  genre: LibraryGenre
+}
+
+
+libraryGenreFromString : String -> LibraryGenre
+libraryGenreFromString s =
+    case s of
+        "GENRE_UNSPECIFIED" ->
+            LibraryGenreUnspecified
+
+        "GENRE_FICTION" ->
+            LibraryGenreFiction
+
+        "GENRE_HISTORY" ->
+            LibraryGenreHistory
+
+        _ ->
+            LibraryGenreUnrecognized s
+
+
+libraryGenreToString : LibraryGenre -> String
+libraryGenreToString v =
+    case v of
+        LibraryGenreUnspecified ->
+            "GENRE_UNSPECIFIED"
+
+        LibraryGenreFiction ->
+            "GENRE_FICTION"
+
+        LibraryGenreHistory ->
+            "GENRE_HISTORY"
+
+        LibraryGenreUnrecognized s ->
+            s
+
+
+libraryGenreDecoder : Decoder LibraryGenre
+libraryGenreDecoder =
+    oneOf
+        [ map libraryGenreFromString string
+        , int
+            |> map
+                (\n ->
+                    case n of
+                        0 ->
+                            LibraryGenreUnspecified
+
+                        1 ->
+                            LibraryGenreFiction
+
+                        2 ->
+                            LibraryGenreHistory
+
+                        _ ->
+                            LibraryGenreUnrecognized (String.fromInt n)
+                )
+        ]
+
+
+libraryBookDecoder : Decoder LibraryBook
+libraryBookDecoder =
+    succeed LibraryBook
+        |> andMap (fieldWithDefault "name" "name" "" string)
+        |> andMap (fieldWithDefault "title" "title" "" string)
+        |> andMap (fieldWithDefault "genre" "genre" (libraryGenreFromString "GENRE_UNSPECIFIED") libraryGenreDecoder)
+        |> andMap (fieldWithDefault "authors" "authors" [] (list string))
+
+
+libraryFilterDecoder : Decoder LibraryFilter
+libraryFilterDecoder =
+    succeed LibraryFilter
+        |> andMap (fieldWithDefault "title" "title" "" string)
+        |> andMap (fieldWithDefault "genres" "genres" [] (list libraryGenreDecoder))
+        |> andMap (optionalField "available" "available" bool)
+        |> andMap (optionalField "publishedAfter" "published_after" string)
+
+
+libraryGetBookRequestDecoder : Decoder LibraryGetBookRequest
+libraryGetBookRequestDecoder =
+    succeed LibraryGetBookRequest
+        |> andMap (fieldWithDefault "name" "name" "" string)
+
+
+libraryListBooksRequestDecoder : Decoder LibraryListBooksRequest
+libraryListBooksRequestDecoder =
+    succeed LibraryListBooksRequest
+        |> andMap (fieldWithDefault "shelf" "shelf" 0 int)
+        |> andMap (fieldWithDefault "pageSize" "page_size" 0 int)
+        |> andMap (fieldWithDefault "pageToken" "page_token" "" string)
+        |> andMap (optionalField "filter" "filter" (lazy (\_ -> libraryFilterDecoder)))
+        |> andMap (fieldWithDefault "labels" "labels" Dict.empty (dict string))
+
+
+libraryListBooksResponseDecoder : Decoder LibraryListBooksResponse
+libraryListBooksResponseDecoder =
+    succeed LibraryListBooksResponse
+        |> andMap (fieldWithDefault "books" "books" [] (list (lazy (\_ -> libraryBookDecoder))))
+        |> andMap (fieldWithDefault "nextPageToken" "next_page_token" "" string)
+
+
+libraryCreateBookRequestDecoder : Decoder LibraryCreateBookRequest
+libraryCreateBookRequestDecoder =
+    succeed LibraryCreateBookRequest
+        |> andMap (fieldWithDefault "shelf" "shelf" 0 int)
+        |> andMap (optionalField "book" "book" (lazy (\_ -> libraryBookDecoder)))
+        |> andMap (fieldWithDefault "requestId" "request_id" "" string)
+
+
+libraryDeleteBookRequestDecoder : Decoder LibraryDeleteBookRequest
+libraryDeleteBookRequestDecoder =
+    succeed LibraryDeleteBookRequest
+        |> andMap (fieldWithDefault "name" "name" "" string)
+        |> andMap (fieldWithDefault "force" "force" False bool)
+
+
+libraryMoveBookRequestDecoder : Decoder LibraryMoveBookRequest
+libraryMoveBookRequestDecoder =
+    succeed LibraryMoveBookRequest
+        |> andMap (fieldWithDefault "name" "name" "" string)
+        |> andMap (fieldWithDefault "genre" "genre" (libraryGenreFromString "GENRE_UNSPECIFIED") libraryGenreDecoder)
+
+
+encodeLibraryGenre : LibraryGenre -> JE.Value
+encodeLibraryGenre v =
+    JE.string (libraryGenreToString v)
+
+
+encodeLibraryBook : LibraryBook -> JE.Value
+encodeLibraryBook v =
+    JE.object
+        (List.filterMap identity
+            [ Just ( "name", JE.string v.name )
+            , Just ( "title", JE.string v.title )
+            , Just ( "genre", encodeLibraryGenre v.genre )
+            , Just ( "authors", (JE.list JE.string) v.authors )
+            ]
+        )
+
+
+encodeLibraryFilter : LibraryFilter -> JE.Value
+encodeLibraryFilter v =
+    JE.object
+        (List.filterMap identity
+            [ Just ( "title", JE.string v.title )
+            , Just ( "genres", (JE.list encodeLibraryGenre) v.genres )
+            , Maybe.map (\x -> ( "available", JE.bool x )) v.available
+            , Maybe.map (\x -> ( "publishedAfter", JE.string x )) v.published_after
+            ]
+        )
+
+
+encodeLibraryGetBookRequest : LibraryGetBookRequest -> JE.Value
+encodeLibraryGetBookRequest v =
+    JE.object
+        (List.filterMap identity
+            [ Just ( "name", JE.string v.name )
+            ]
+        )
+
+
+encodeLibraryListBooksRequest : LibraryListBooksRequest -> JE.Value
+encodeLibraryListBooksRequest v =
+    JE.object
+        (List.filterMap identity
+            [ Just ( "shelf", JE.int v.shelf )
+            , Just ( "pageSize", JE.int v.page_size )
+            , Just ( "pageToken", JE.string v.page_token )
+            , Maybe.map (\x -> ( "filter", encodeLibraryFilter x )) v.filter
+            , Just ( "labels", (JE.dict identity JE.string) v.labels )
+            ]
+        )
+
+
+encodeLibraryListBooksResponse : LibraryListBooksResponse -> JE.Value
+encodeLibraryListBooksResponse v =
+    JE.object
+        (List.filterMap identity
+            [ Just ( "books", (JE.list encodeLibraryBook) v.books )
+            , Just ( "nextPageToken", JE.string v.next_page_token )
+            ]
+        )
+
+
+encodeLibraryCreateBookRequest : LibraryCreateBookRequest -> JE.Value
+encodeLibraryCreateBookRequest v =
+    JE.object
+        (List.filterMap identity
+            [ Just ( "shelf", JE.int v.shelf )
+            , Maybe.map (\x -> ( "book", encodeLibraryBook x )) v.book
+            , Just ( "requestId", JE.string v.request_id )
+            ]
+        )
+
+
+encodeLibraryDeleteBookRequest : LibraryDeleteBookRequest -> JE.Value
+encodeLibraryDeleteBookRequest v =
+    JE.object
+        (List.filterMap identity
+            [ Just ( "name", JE.string v.name )
+            , Just ( "force", JE.bool v.force )
+            ]
+        )
+
+
+encodeLibraryMoveBookRequest : LibraryMoveBookRequest -> JE.Value
+encodeLibraryMoveBookRequest v =
+    JE.object
+        (List.filterMap identity
+            [ Just ( "name", JE.string v.name )
+            , Just ( "genre", encodeLibraryGenre v.genre )
+            ]
+        )
+
+
+libraryGetBook : String -> (Result Http.Error LibraryBook -> msg) -> LibraryGetBookRequest -> Cmd msg
+libraryGetBook baseUrl toMsg request =
+    Http.request
+        { method = "GET"
+        , headers = []
+        , url = baseUrl ++ "/v1/" ++ request.name
+        , body = Http.emptyBody
+        , expect = Http.expectJson toMsg (lazy (\_ -> libraryBookDecoder))
+        , timeout = Nothing
+        , tracker = Nothing
+        }
+
+
+libraryListBooks : String -> (Result Http.Error LibraryListBooksResponse -> msg) -> LibraryListBooksRequest -> Cmd msg
+libraryListBooks baseUrl toMsg request =
+    Http.request
+        { method = "GET"
+        , headers = []
+        , url = baseUrl ++ "/v1/shelves/" ++ Url.percentEncode (String.fromInt request.shelf) ++ "/books" ++ Url.Builder.toQuery (List.concat [ [ (Url.Builder.string "page_size" << String.fromInt) request.page_size ], [ (Url.Builder.string "page_token") request.page_token ], (request.filter |> Maybe.map (\nested1 -> List.concat [ [ (Url.Builder.string "filter.title") nested1.title ], List.map (Url.Builder.string "filter.genres" << libraryGenreToString) nested1.genres, (nested1.available |> Maybe.map (List.singleton << (Url.Builder.string "filter.available" << (\b -> if b then "true" else "false"))) |> Maybe.withDefault []), (nested1.published_after |> Maybe.map (List.singleton << (Url.Builder.string "filter.published_after")) |> Maybe.withDefault []) ]) |> Maybe.withDefault []) ])
+        , body = Http.emptyBody
+        , expect = Http.expectJson toMsg (lazy (\_ -> libraryListBooksResponseDecoder))
+        , timeout = Nothing
+        , tracker = Nothing
+        }
+
+
+libraryCreateBook : String -> (Result Http.Error LibraryBook -> msg) -> LibraryCreateBookRequest -> Cmd msg
+libraryCreateBook baseUrl toMsg request =
+    Http.request
+        { method = "POST"
+        , headers = []
+        , url = baseUrl ++ "/v1/shelves/" ++ Url.percentEncode (String.fromInt request.shelf) ++ "/books" ++ Url.Builder.toQuery (List.concat [ [ (Url.Builder.string "request_id") request.request_id ] ])
+        , body = Http.jsonBody (request.book |> Maybe.map encodeLibraryBook |> Maybe.withDefault JE.null)
+        , expect = Http.expectJson toMsg (lazy (\_ -> libraryBookDecoder))
+        , timeout = Nothing
+        , tracker = Nothing
+        }
+
+
+libraryUpdateBook : String -> (Result Http.Error LibraryBook -> msg) -> LibraryBook -> Cmd msg
+libraryUpdateBook baseUrl toMsg request =
+    Http.request
+        { method = "PATCH"
+        , headers = []
+        , url = baseUrl ++ "/v1/" ++ request.name
+        , body = Http.jsonBody (encodeLibraryBook request)
+        , expect = Http.expectJson toMsg (lazy (\_ -> libraryBookDecoder))
+        , timeout = Nothing
+        , tracker = Nothing
+        }
+
+
+libraryDeleteBook : String -> (Result Http.Error {} -> msg) -> LibraryDeleteBookRequest -> Cmd msg
+libraryDeleteBook baseUrl toMsg request =
+    Http.request
+        { method = "DELETE"
+        , headers = []
+        , url = baseUrl ++ "/v1/" ++ request.name ++ Url.Builder.toQuery (List.concat [ [ (Url.Builder.string "force" << (\b -> if b then "true" else "false")) request.force ] ])
+        , body = Http.emptyBody
+        , expect = Http.expectJson toMsg (succeed {})
+        , timeout = Nothing
+        , tracker = Nothing
+        }
+
+
+libraryMoveBook : String -> (Result Http.Error LibraryBook -> msg) -> LibraryMoveBookRequest -> Cmd msg
+libraryMoveBook baseUrl toMsg request =
+    Http.request
+        { method = "POST"
+        , headers = []
+        , url = baseUrl ++ "/v1/genres/" ++ Url.percentEncode (libraryGenreToString request.genre) ++ "/books" ++ Url.Builder.toQuery (List.concat [ [ (Url.Builder.string "name") request.name ] ])
+        , body = Http.emptyBody
+        , expect = Http.expectJson toMsg (lazy (\_ -> libraryBookDecoder))
+        , timeout = Nothing
+        , tracker = Nothing
+        }
+
+
+libraryRecommendBook : String -> (Result Http.Error LibraryBook -> msg) -> {} -> Cmd msg
+libraryRecommendBook baseUrl toMsg request =
+    Http.request
+        { method = "POST"
+        , headers = []
+        , url = baseUrl ++ "/library.Library/RecommendBook"
+        , body = Http.jsonBody ((\_ -> JE.object []) request)
+        , expect = Http.expectJson toMsg (lazy (\_ -> libraryBookDecoder))
+        , timeout = Nothing
+        , tracker = Nothing
+        }
+
+
+andMap : Decoder a -> Decoder (a -> b) -> Decoder b
+andMap =
+    map2 (|>)
+
+
+fieldWithDefault : String -> String -> a -> Decoder a -> Decoder a
+fieldWithDefault jsonName name default decoder =
+    optionalField jsonName name decoder
+        |> map (Maybe.withDefault default)
+
+
+int64 : Decoder String
+int64 =
+    oneOf [ string, map String.fromInt int ]
+
+
+oneOfFields : List (Decoder (Maybe a)) -> a -> Decoder a
+oneOfFields decoders notSet =
+    case decoders of
+        [] ->
+            succeed notSet
+
+        decoder :: rest ->
+            decoder
+                |> andThen
+                    (\member ->
+                        case member of
+                            Just m ->
+                                succeed m
+
+                            Nothing ->
+                                oneOfFields rest notSet
+                    )
+
+
+optionalField : String -> String -> Decoder a -> Decoder (Maybe a)
+optionalField jsonName name decoder =
+    value
+        |> andThen
+            (\json ->
+                case ( decodeValue (field jsonName value) json, decodeValue (field name value) json ) of
+                    ( Ok _, _ ) ->
+                        field jsonName (nullable decoder)
+
+                    ( _, Ok _ ) ->
+                        field name (nullable decoder)
+
+                    _ ->
+                        succeed Nothing
+            )
+
+
+requiredField : String -> String -> Decoder a -> Decoder a
+requiredField jsonName name decoder =
+    oneOf [ field jsonName decoder, field name decoder ]
@@ -0,0 +1,188 @@
+-- this is a generated file
+module Maps.Maps exposing (..)
+import Json.Encode as JE
+import Json.Decode exposing (..)
+import Dict exposing (Dict)
+
+type MapsLevel = MapsLevelUnspecified
+    | MapsLevelHigh
+    | MapsLevelUnrecognized String
+
+type alias MapsItem = {
+  name: String
+}
+
+type alias MapsInventory = {
+  counts: Dict String Int,
+  names: Dict String String,
+  items: Dict String MapsItem,
+  levels: Dict String MapsLevel,
+  blobs: Dict String String,
+  total: String,
+  capacity: String,
+  delta: String,
+  checksum: String,
+  ids: List String,
+  data: String,
+  chunks: List String
+}
+
+
+mapsLevelFromString : String -> MapsLevel
+mapsLevelFromString s =
+    case s of
+        "LEVEL_UNSPECIFIED" ->
+            MapsLevelUnspecified
+
+        "LEVEL_HIGH" ->
+            MapsLevelHigh
+
+        _ ->
+            MapsLevelUnrecognized s
+
+
+mapsLevelToString : MapsLevel -> String
+mapsLevelToString v =
+    case v of
+        MapsLevelUnspecified ->
+            "LEVEL_UNSPECIFIED"
+
+        MapsLevelHigh ->
+            "LEVEL_HIGH"
+
+        MapsLevelUnrecognized s ->
+            s
+
+
+mapsLevelDecoder : Decoder MapsLevel
+mapsLevelDecoder =
+    oneOf
+        [ map mapsLevelFromString string
+        , int
+            |> map
+                (\n ->
+                    case n of
+                        0 ->
+                            MapsLevelUnspecified
+
+                        1 ->
+                            MapsLevelHigh
+
+                        _ ->
+                            MapsLevelUnrecognized (String.fromInt n)
+                )
+        ]
+
+
+mapsItemDecoder : Decoder MapsItem
+mapsItemDecoder =
+    succeed MapsItem
+        |> andMap (fieldWithDefault "name" "name" "" string)
+
+
+mapsInventoryDecoder : Decoder MapsInventory
+mapsInventoryDecoder =
+    succeed MapsInventory
+        |> andMap (fieldWithDefault "counts" "counts" Dict.empty (dict int))
+        |> andMap (fieldWithDefault "names" "names" Dict.empty (dict string))
+        |> andMap (fieldWithDefault "items" "items" Dict.empty (dict (lazy (\_ -> mapsItemDecoder))))
+        |> andMap (fieldWithDefault "levels" "levels" Dict.empty (dict mapsLevelDecoder))
+        |> andMap (fieldWithDefault "blobs" "blobs" Dict.empty (dict string))
+        |> andMap (fieldWithDefault "total" "total" "0" int64)
+        |> andMap (fieldWithDefault "capacity" "capacity" "0" int64)
+        |> andMap (fieldWithDefault "delta" "delta" "0" int64)
+        |> andMap (fieldWithDefault "checksum" "checksum" "0" int64)
+        |> andMap (fieldWithDefault "ids" "ids" [] (list int64))
+        |> andMap (fieldWithDefault "data" "data" "" string)
+        |> andMap (fieldWithDefault "chunks" "chunks" [] (list string))
+
+
+encodeMapsLevel : MapsLevel -> JE.Value
+encodeMapsLevel v =
+    JE.string (mapsLevelToString v)
+
+
+encodeMapsItem : MapsItem -> JE.Value
+encodeMapsItem v =
+    JE.object
+        (List.filterMap identity
+            [ Just ( "name", JE.string v.name )
+            ]
+        )
+
+
+encodeMapsInventory : MapsInventory -> JE.Value
+encodeMapsInventory v =
+    JE.object
+        (List.filterMap identity
+            [ Just ( "counts", (JE.dict identity JE.int) v.counts )
+            , Just ( "names", (JE.dict identity JE.string) v.names )
+            , Just ( "items", (JE.dict identity encodeMapsItem) v.items )
+            , Just ( "levels", (JE.dict identity encodeMapsLevel) v.levels )
+            , Just ( "blobs", (JE.dict identity JE.string) v.blobs )
+            , Just ( "total", JE.string v.total )
+            , Just ( "capacity", JE.string v.capacity )
+            , Just ( "delta", JE.string v.delta )
+            , Just ( "checksum", JE.string v.checksum )
+            , Just ( "ids", (JE.list JE.string) v.ids )
+            , Just ( "data", JE.string v.data )
+            , Just ( "chunks", (JE.list JE.string) v.chunks )
+            ]
+        )
+
+
+andMap : Decoder a -> Decoder (a -> b) -> Decoder b
+andMap =
+    map2 (|>)
+
+
+fieldWithDefault : String -> String -> a -> Decoder a -> Decoder a
+fieldWithDefault jsonName name default decoder =
+    optionalField jsonName name decoder
+        |> map (Maybe.withDefault default)
+
+
+int64 : Decoder String
+int64 =
+    oneOf [ string, map String.fromInt int ]
+
+
+oneOfFields : List (Decoder (Maybe a)) -> a -> Decoder a
+oneOfFields decoders notSet =
+    case decoders of
+        [] ->
+            succeed notSet
+
+        decoder :: rest ->
+            decoder
+                |> andThen
+                    (\member ->
+                        case member of
+                            Just m ->
+                                succeed m
+
+                            Nothing ->
+                                oneOfFields rest notSet
+                    )
+
+
+optionalField : String -> String -> Decoder a -> Decoder (Maybe a)
+optionalField jsonName name decoder =
+    value
+        |> andThen
+            (\json ->
+                case ( decodeValue (field jsonName value) json, decodeValue (field name value) json ) of
+                    ( Ok _, _ ) ->
+                        field jsonName (nullable decoder)
+
+                    ( _, Ok _ ) ->
+                        field name (nullable decoder)
+
+                    _ ->
+                        succeed Nothing
+            )
+
+
+requiredField : String -> String -> Decoder a -> Decoder a
+requiredField jsonName name decoder =
+    oneOf [ field jsonName decoder, field name decoder ]
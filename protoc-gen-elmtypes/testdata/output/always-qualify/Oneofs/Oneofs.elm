@@ -0,0 +1,251 @@
+-- this is a generated file
+module Oneofs.Oneofs exposing (..)
+import Json.Encode as JE
+import Json.Decode exposing (..)
+import Dict exposing (Dict)
+
+type alias OneofsImage = {
+  url: String
+}
+
+type alias OneofsPost = {
+  id: String,
+  content: OneofsPostContentOneof,
+  audience: OneofsPostAudienceOneof
+}
+
+type OneofsPostContentOneof = OneofsPostContentOneofText String
+    | OneofsPostContentOneofImage OneofsImage
+    | OneofsPostContentOneofNotSet
+
+type OneofsPostAudienceOneof = OneofsPostAudienceOneofPublic Bool
+    | OneofsPostAudienceOneofGroupId String
+    | OneofsPostAudienceOneofNotSet
+
+type alias OneofsInvoice = {
+  payment: OneofsInvoicePaymentOneof,
+  type_: OneofsInvoiceTypeOneof
+}
+
+type OneofsInvoicePaymentOneof = OneofsInvoicePaymentOneofCard String
+    | OneofsInvoicePaymentOneofTransfer OneofsInvoicePayment
+    | OneofsInvoicePaymentOneofNotSet
+
+type OneofsInvoiceTypeOneof = OneofsInvoiceTypeOneofNumber String
+    | OneofsInvoiceTypeOneofCode String
+    | OneofsInvoiceTypeOneofNotSet
+
+type alias OneofsInvoicePayment = {
+  reference: String
+}
+
+
+oneofsImageDecoder : Decoder OneofsImage
+oneofsImageDecoder =
+    succeed OneofsImage
+        |> andMap (fieldWithDefault "url" "url" "" string)
+
+
+oneofsPostDecoder : Decoder OneofsPost
+oneofsPostDecoder =
+    succeed OneofsPost
+        |> andMap (fieldWithDefault "id" "id" "" string)
+        |> andMap oneofsPostContentOneofDecoder
+        |> andMap oneofsPostAudienceOneofDecoder
+
+
+oneofsPostContentOneofDecoder : Decoder OneofsPostContentOneof
+oneofsPostContentOneofDecoder =
+    oneOfFields
+        [ map (Maybe.map OneofsPostContentOneofText) (optionalField "text" "text" string)
+        , map (Maybe.map OneofsPostContentOneofImage) (optionalField "image" "image" (lazy (\_ -> oneofsImageDecoder)))
+        ]
+        OneofsPostContentOneofNotSet
+
+
+oneofsPostAudienceOneofDecoder : Decoder OneofsPostAudienceOneof
+oneofsPostAudienceOneofDecoder =
+    oneOfFields
+        [ map (Maybe.map OneofsPostAudienceOneofPublic) (optionalField "public" "public" bool)
+        , map (Maybe.map OneofsPostAudienceOneofGroupId) (optionalField "groupId" "group_id" string)
+        ]
+        OneofsPostAudienceOneofNotSet
+
+
+oneofsInvoiceDecoder : Decoder OneofsInvoice
+oneofsInvoiceDecoder =
+    succeed OneofsInvoice
+        |> andMap oneofsInvoicePaymentOneofDecoder
+        |> andMap oneofsInvoiceTypeOneofDecoder
+
+
+oneofsInvoicePaymentOneofDecoder : Decoder OneofsInvoicePaymentOneof
+oneofsInvoicePaymentOneofDecoder =
+    oneOfFields
+        [ map (Maybe.map OneofsInvoicePaymentOneofCard) (optionalField "card" "card" string)
+        , map (Maybe.map OneofsInvoicePaymentOneofTransfer) (optionalField "transfer" "transfer" (lazy (\_ -> oneofsInvoicePaymentDecoder)))
+        ]
+        OneofsInvoicePaymentOneofNotSet
+
+
+oneofsInvoiceTypeOneofDecoder : Decoder OneofsInvoiceTypeOneof
+oneofsInvoiceTypeOneofDecoder =
+    oneOfFields
+        [ map (Maybe.map OneofsInvoiceTypeOneofNumber) (optionalField "number" "number" int64)
+        , map (Maybe.map OneofsInvoiceTypeOneofCode) (optionalField "code" "code" string)
+        ]
+        OneofsInvoiceTypeOneofNotSet
+
+
+oneofsInvoicePaymentDecoder : Decoder OneofsInvoicePayment
+oneofsInvoicePaymentDecoder =
+    succeed OneofsInvoicePayment
+        |> andMap (fieldWithDefault "reference" "reference" "" string)
+
+
+encodeOneofsImage : OneofsImage -> JE.Value
+encodeOneofsImage v =
+    JE.object
+        (List.filterMap identity
+            [ Just ( "url", JE.string v.url )
+            ]
+        )
+
+
+encodeOneofsPost : OneofsPost -> JE.Value
+encodeOneofsPost v =
+    JE.object
+        (List.filterMap identity
+            [ Just ( "id", JE.string v.id )
+            , encodeOneofsPostContentOneof v.content
+            , encodeOneofsPostAudienceOneof v.audience
+            ]
+        )
+
+
+encodeOneofsPostContentOneof : OneofsPostContentOneof -> Maybe ( String, JE.Value )
+encodeOneofsPostContentOneof v =
+    case v of
+        OneofsPostContentOneofText x ->
+            Just ( "text", JE.string x )
+
+        OneofsPostContentOneofImage x ->
+            Just ( "image", encodeOneofsImage x )
+
+        OneofsPostContentOneofNotSet ->
+            Nothing
+
+
+encodeOneofsPostAudienceOneof : OneofsPostAudienceOneof -> Maybe ( String, JE.Value )
+encodeOneofsPostAudienceOneof v =
+    case v of
+        OneofsPostAudienceOneofPublic x ->
+            Just ( "public", JE.bool x )
+
+        OneofsPostAudienceOneofGroupId x ->
+            Just ( "groupId", JE.string x )
+
+        OneofsPostAudienceOneofNotSet ->
+            Nothing
+
+
+encodeOneofsInvoice : OneofsInvoice -> JE.Value
+encodeOneofsInvoice v =
+    JE.object
+        (List.filterMap identity
+            [ encodeOneofsInvoicePaymentOneof v.payment
+            , encodeOneofsInvoiceTypeOneof v.type_
+            ]
+        )
+
+
+encodeOneofsInvoicePaymentOneof : OneofsInvoicePaymentOneof -> Maybe ( String, JE.Value )
+encodeOneofsInvoicePaymentOneof v =
+    case v of
+        OneofsInvoicePaymentOneofCard x ->
+            Just ( "card", JE.string x )
+
+        OneofsInvoicePaymentOneofTransfer x ->
+            Just ( "transfer", encodeOneofsInvoicePayment x )
+
+        OneofsInvoicePaymentOneofNotSet ->
+            Nothing
+
+
+encodeOneofsInvoiceTypeOneof : OneofsInvoiceTypeOneof -> Maybe ( String, JE.Value )
+encodeOneofsInvoiceTypeOneof v =
+    case v of
+        OneofsInvoiceTypeOneofNumber x ->
+            Just ( "number", JE.string x )
+
+        OneofsInvoiceTypeOneofCode x ->
+            Just ( "code", JE.string x )
+
+        OneofsInvoiceTypeOneofNotSet ->
+            Nothing
+
+
+encodeOneofsInvoicePayment : OneofsInvoicePayment -> JE.Value
+encodeOneofsInvoicePayment v =
+    JE.object
+        (List.filterMap identity
+            [ Just ( "reference", JE.string v.reference )
+            ]
+        )
+
+
+andMap : Decoder a -> Decoder (a -> b) -> Decoder b
+andMap =
+    map2 (|>)
+
+
+fieldWithDefault : String -> String -> a -> Decoder a -> Decoder a
+fieldWithDefault jsonName name default decoder =
+    optionalField jsonName name decoder
+        |> map (Maybe.withDefault default)
+
+
+int64 : Decoder String
+int64 =
+    oneOf [ string, map String.fromInt int ]
+
+
+oneOfFields : List (Decoder (Maybe a)) -> a -> Decoder a
+oneOfFields decoders notSet =
+    case decoders of
+        [] ->
+            succeed notSet
+
+        decoder :: rest ->
+            decoder
+                |> andThen
+                    (\member ->
+                        case member of
+                            Just m ->
+                                succeed m
+
+                            Nothing ->
+                                oneOfFields rest notSet
+                    )
+
+
+optionalField : String -> String -> Decoder a -> Decoder (Maybe a)
+optionalField jsonName name decoder =
+    value
+        |> andThen
+            (\json ->
+                case ( decodeValue (field jsonName value) json, decodeValue (field name value) json ) of
+                    ( Ok _, _ ) ->
+                        field jsonName (nullable decoder)
+
+                    ( _, Ok _ ) ->
+                        field name (nullable decoder)
+
+                    _ ->
+                        succeed Nothing
+            )
+
+
+requiredField : String -> String -> Decoder a -> Decoder a
+requiredField jsonName name decoder =
+    oneOf [ field jsonName decoder, field name decoder ]
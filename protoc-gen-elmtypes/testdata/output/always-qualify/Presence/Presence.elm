@@ -0,0 +1,204 @@
+-- this is a generated file
+module Presence.Presence exposing (..)
+import Json.Encode as JE
+import Json.Decode exposing (..)
+import Dict exposing (Dict)
+
+type PresenceColor = PresenceColorUnspecified
+    | PresenceColorRed
+    | PresenceColorUnrecognized String
+
+type alias PresenceItem = {
+  name: String
+}
+
+type alias PresencePresence = {
+  name: String,
+  nickname: Maybe String,
+  count: Maybe String,
+  color: Maybe PresenceColor,
+  item: Maybe PresenceItem,
+  required_item: PresenceItem,
+  annotated_item: PresenceItem,
+  required_nickname: String,
+  items: List PresenceItem
+}
+
+type alias PresenceRequired = {
+  item: PresenceItem,
+  note: String,
+  optional_item: Maybe PresenceItem
+}
+
+
+presenceColorFromString : String -> PresenceColor
+presenceColorFromString s =
+    case s of
+        "COLOR_UNSPECIFIED" ->
+            PresenceColorUnspecified
+
+        "COLOR_RED" ->
+            PresenceColorRed
+
+        _ ->
+            PresenceColorUnrecognized s
+
+
+presenceColorToString : PresenceColor -> String
+presenceColorToString v =
+    case v of
+        PresenceColorUnspecified ->
+            "COLOR_UNSPECIFIED"
+
+        PresenceColorRed ->
+            "COLOR_RED"
+
+        PresenceColorUnrecognized s ->
+            s
+
+
+presenceColorDecoder : Decoder PresenceColor
+presenceColorDecoder =
+    oneOf
+        [ map presenceColorFromString string
+        , int
+            |> map
+                (\n ->
+                    case n of
+                        0 ->
+                            PresenceColorUnspecified
+
+                        1 ->
+                            PresenceColorRed
+
+                        _ ->
+                            PresenceColorUnrecognized (String.fromInt n)
+                )
+        ]
+
+
+presenceItemDecoder : Decoder PresenceItem
+presenceItemDecoder =
+    succeed PresenceItem
+        |> andMap (fieldWithDefault "name" "name" "" string)
+
+
+presencePresenceDecoder : Decoder PresencePresence
+presencePresenceDecoder =
+    succeed PresencePresence
+        |> andMap (fieldWithDefault "name" "name" "" string)
+        |> andMap (optionalField "nickname" "nickname" string)
+        |> andMap (optionalField "count" "count" int64)
+        |> andMap (optionalField "color" "color" presenceColorDecoder)
+        |> andMap (optionalField "item" "item" (lazy (\_ -> presenceItemDecoder)))
+        |> andMap (requiredField "requiredItem" "required_item" (lazy (\_ -> presenceItemDecoder)))
+        |> andMap (requiredField "annotatedItem" "annotated_item" (lazy (\_ -> presenceItemDecoder)))
+        |> andMap (requiredField "requiredNickname" "required_nickname" string)
+        |> andMap (fieldWithDefault "items" "items" [] (list (lazy (\_ -> presenceItemDecoder))))
+
+
+presenceRequiredDecoder : Decoder PresenceRequired
+presenceRequiredDecoder =
+    succeed PresenceRequired
+        |> andMap (requiredField "item" "item" (lazy (\_ -> presenceItemDecoder)))
+        |> andMap (requiredField "note" "note" string)
+        |> andMap (optionalField "optionalItem" "optional_item" (lazy (\_ -> presenceItemDecoder)))
+
+
+encodePresenceColor : PresenceColor -> JE.Value
+encodePresenceColor v =
+    JE.string (presenceColorToString v)
+
+
+encodePresenceItem : PresenceItem -> JE.Value
+encodePresenceItem v =
+    JE.object
+        (List.filterMap identity
+            [ Just ( "name", JE.string v.name )
+            ]
+        )
+
+
+encodePresencePresence : PresencePresence -> JE.Value
+encodePresencePresence v =
+    JE.object
+        (List.filterMap identity
+            [ Just ( "name", JE.string v.name )
+            , Maybe.map (\x -> ( "nickname", JE.string x )) v.nickname
+            , Maybe.map (\x -> ( "count", JE.string x )) v.count
+            , Maybe.map (\x -> ( "color", encodePresenceColor x )) v.color
+            , Maybe.map (\x -> ( "item", encodePresenceItem x )) v.item
+            , Just ( "requiredItem", encodePresenceItem v.required_item )
+            , Just ( "annotatedItem", encodePresenceItem v.annotated_item )
+            , Just ( "requiredNickname", JE.string v.required_nickname )
+            , Just ( "items", (JE.list encodePresenceItem) v.items )
+            ]
+        )
+
+
+encodePresenceRequired : PresenceRequired -> JE.Value
+encodePresenceRequired v =
+    JE.object
+        (List.filterMap identity
+            [ Just ( "item", encodePresenceItem v.item )
+            , Just ( "note", JE.string v.note )
+            , Maybe.map (\x -> ( "optionalItem", encodePresenceItem x )) v.optional_item
+            ]
+        )
+
+
+andMap : Decoder a -> Decoder (a -> b) -> Decoder b
+andMap =
+    map2 (|>)
+
+
+fieldWithDefault : String -> String -> a -> Decoder a -> Decoder a
+fieldWithDefault jsonName name default decoder =
+    optionalField jsonName name decoder
+        |> map (Maybe.withDefault default)
+
+
+int64 : Decoder String
+int64 =
+    oneOf [ string, map String.fromInt int ]
+
+
+oneOfFields : List (Decoder (Maybe a)) -> a -> Decoder a
+oneOfFields decoders notSet =
+    case decoders of
+        [] ->
+            succeed notSet
+
+        decoder :: rest ->
+            decoder
+                |> andThen
+                    (\member ->
+                        case member of
+                            Just m ->
+                                succeed m
+
+                            Nothing ->
+                                oneOfFields rest notSet
+                    )
+
+
+optionalField : String -> String -> Decoder a -> Decoder (Maybe a)
+optionalField jsonName name decoder =
+    value
+        |> andThen
+            (\json ->
+                case ( decodeValue (field jsonName value) json, decodeValue (field name value) json ) of
+                    ( Ok _, _ ) ->
+                        field jsonName (nullable decoder)
+
+                    ( _, Ok _ ) ->
+                        field name (nullable decoder)
+
+                    _ ->
+                        succeed Nothing
+            )
+
+
+requiredField : String -> String -> Decoder a -> Decoder a
+requiredField jsonName name decoder =
+    oneOf [ field jsonName decoder, field name decoder ]
@@ -0,0 +1,173 @@
+-- this is a generated file
+module Presence2.Presence2 exposing (..)
+import Json.Encode as JE
+import Json.Decode exposing (..)
+import Dict exposing (Dict)
+
+type Presence2Priority = Presence2PriorityLow
+    | Presence2PriorityHigh
+    | Presence2PriorityUnrecognized String
+
+type alias Presence2Task = {
+  id: String,
+  title: Maybe String,
+  estimate: Maybe Int,
+  priority: Maybe Presence2Priority,
+  required_priority: Presence2Priority,
+  tags: List String,
+  details: Maybe Presence2TaskDetails
+}
+
+type alias Presence2TaskDetails = {
+  description: Maybe String
+}
+
+
+presence2PriorityFromString : String -> Presence2Priority
+presence2PriorityFromString s =
+    case s of
+        "LOW" ->
+            Presence2PriorityLow
+
+        "HIGH" ->
+            Presence2PriorityHigh
+
+        _ ->
+            Presence2PriorityUnrecognized s
+
+
+presence2PriorityToString : Presence2Priority -> String
+presence2PriorityToString v =
+    case v of
+        Presence2PriorityLow ->
+            "LOW"
+
+        Presence2PriorityHigh ->
+            "HIGH"
+
+        Presence2PriorityUnrecognized s ->
+            s
+
+
+presence2PriorityDecoder : Decoder Presence2Priority
+presence2PriorityDecoder =
+    oneOf
+        [ map presence2PriorityFromString string
+        , int
+            |> map
+                (\n ->
+                    case n of
+                        1 ->
+                            Presence2PriorityLow
+
+                        2 ->
+                            Presence2PriorityHigh
+
+                        _ ->
+                            Presence2PriorityUnrecognized (String.fromInt n)
+                )
+        ]
+
+
+presence2TaskDecoder : Decoder Presence2Task
+presence2TaskDecoder =
+    succeed Presence2Task
+        |> andMap (requiredField "id" "id" string)
+        |> andMap (optionalField "title" "title" string)
+        |> andMap (optionalField "estimate" "estimate" int)
+        |> andMap (optionalField "priority" "priority" presence2PriorityDecoder)
+        |> andMap (requiredField "requiredPriority" "required_priority" presence2PriorityDecoder)
+        |> andMap (fieldWithDefault "tags" "tags" [] (list string))
+        |> andMap (optionalField "details" "details" (lazy (\_ -> presence2TaskDetailsDecoder)))
+
+
+presence2TaskDetailsDecoder : Decoder Presence2TaskDetails
+presence2TaskDetailsDecoder =
+    succeed Presence2TaskDetails
+        |> andMap (optionalField "description" "description" string)
+
+
+encodePresence2Priority : Presence2Priority -> JE.Value
+encodePresence2Priority v =
+    JE.string (presence2PriorityToString v)
+
+
+encodePresence2Task : Presence2Task -> JE.Value
+encodePresence2Task v =
+    JE.object
+        (List.filterMap identity
+            [ Just ( "id", JE.string v.id )
+            , Maybe.map (\x -> ( "title", JE.string x )) v.title
+            , Maybe.map (\x -> ( "estimate", JE.int x )) v.estimate
+            , Maybe.map (\x -> ( "priority", encodePresence2Priority x )) v.priority
+            , Just ( "requiredPriority", encodePresence2Priority v.required_priority )
+            , Just ( "tags", (JE.list JE.string) v.tags )
+            , Maybe.map (\x -> ( "details", encodePresence2TaskDetails x )) v.details
+            ]
+        )
+
+
+encodePresence2TaskDetails : Presence2TaskDetails -> JE.Value
+encodePresence2TaskDetails v =
+    JE.object
+        (List.filterMap identity
+            [ Maybe.map (\x -> ( "description", JE.string x )) v.description
+            ]
+        )
+
+
+andMap : Decoder a -> Decoder (a -> b) -> Decoder b
+andMap =
+    map2 (|>)
+
+
+fieldWithDefault : String -> String -> a -> Decoder a -> Decoder a
+fieldWithDefault jsonName name default decoder =
+    optionalField jsonName name decoder
+        |> map (Maybe.withDefault default)
+
+
+int64 : Decoder String
+int64 =
+    oneOf [ string, map String.fromInt int ]
+
+
+oneOfFields : List (Decoder (Maybe a)) -> a -> Decoder a
+oneOfFields decoders notSet =
+    case decoders of
+        [] ->
+            succeed notSet
+
+        decoder :: rest ->
+            decoder
+                |> andThen
+                    (\member ->
+                        case member of
+                            Just m ->
+                                succeed m
+
+                            Nothing ->
+                                oneOfFields rest notSet
+                    )
+
+
+optionalField : String -> String -> Decoder a -> Decoder (Maybe a)
+optionalField jsonName name decoder =
+    value
+        |> andThen
+            (\json ->
+                case ( decodeValue (field jsonName value) json, decodeValue (field name value) json ) of
+                    ( Ok _, _ ) ->
+                        field jsonName (nullable decoder)
+
+                    ( _, Ok _ ) ->
+                        field name (nullable decoder)
+
+                    _ ->
+                        succeed Nothing
+            )
+
+
+requiredField : String -> String -> Decoder a -> Decoder a
+requiredField jsonName name decoder =
+    oneOf [ field jsonName decoder, field name decoder ]
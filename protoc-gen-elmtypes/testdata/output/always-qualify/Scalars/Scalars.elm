@@ -0,0 +1,154 @@
+-- this is a generated file
+module Scalars.Scalars exposing (..)
+import Json.Encode as JE
+import Json.Decode exposing (..)
+import Dict exposing (Dict)
+
+type alias ScalarsScalars = {
+  double_value: Float,
+  float_value: Float,
+  int32_value: Int,
+  uint32_value: Int,
+  sint32_value: Int,
+  bool_value: Bool,
+  string_value: String,
+  string_values: List String,
+  child: Maybe ScalarsScalars,
+  children: List ScalarsScalars
+}
+
+type alias ScalarsKeywords = {
+  type_: String,
+  module_: String,
+  in_: Int,
+  if_: Bool,
+  exposing_: List String,
+  as_: Maybe ScalarsKeywords
+}
+
+type alias ScalarsEmpty = {}
+
+
+scalarsScalarsDecoder : Decoder ScalarsScalars
+scalarsScalarsDecoder =
+    succeed ScalarsScalars
+        |> andMap (fieldWithDefault "doubleValue" "double_value" 0 float)
+        |> andMap (fieldWithDefault "floatValue" "float_value" 0 float)
+        |> andMap (fieldWithDefault "int32Value" "int32_value" 0 int)
+        |> andMap (fieldWithDefault "uint32Value" "uint32_value" 0 int)
+        |> andMap (fieldWithDefault "sint32Value" "sint32_value" 0 int)
+        |> andMap (fieldWithDefault "boolValue" "bool_value" False bool)
+        |> andMap (fieldWithDefault "stringValue" "string_value" "" string)
+        |> andMap (fieldWithDefault "stringValues" "string_values" [] (list string))
+        |> andMap (optionalField "child" "child" (lazy (\_ -> scalarsScalarsDecoder)))
+        |> andMap (fieldWithDefault "children" "children" [] (list (lazy (\_ -> scalarsScalarsDecoder))))
+
+
+scalarsKeywordsDecoder : Decoder ScalarsKeywords
+scalarsKeywordsDecoder =
+    succeed ScalarsKeywords
+        |> andMap (fieldWithDefault "type" "type" "" string)
+        |> andMap (fieldWithDefault "module" "module" "" string)
+        |> andMap (fieldWithDefault "in" "in" 0 int)
+        |> andMap (fieldWithDefault "if" "if" False bool)
+        |> andMap (fieldWithDefault "exposing" "exposing" [] (list string))
+        |> andMap (optionalField "as" "as" (lazy (\_ -> scalarsKeywordsDecoder)))
+
+
+scalarsEmptyDecoder : Decoder ScalarsEmpty
+scalarsEmptyDecoder =
+    succeed {}
+
+
+encodeScalarsScalars : ScalarsScalars -> JE.Value
+encodeScalarsScalars v =
+    JE.object
+        (List.filterMap identity
+            [ Just ( "doubleValue", JE.float v.double_value )
+            , Just ( "floatValue", JE.float v.float_value )
+            , Just ( "int32Value", JE.int v.int32_value )
+            , Just ( "uint32Value", JE.int v.uint32_value )
+            , Just ( "sint32Value", JE.int v.sint32_value )
+            , Just ( "boolValue", JE.bool v.bool_value )
+            , Just ( "stringValue", JE.string v.string_value )
+            , Just ( "stringValues", (JE.list JE.string) v.string_values )
+            , Maybe.map (\x -> ( "child", encodeScalarsScalars x )) v.child
+            , Just ( "children", (JE.list encodeScalarsScalars) v.children )
+            ]
+        )
+
+
+encodeScalarsKeywords : ScalarsKeywords -> JE.Value
+encodeScalarsKeywords v =
+    JE.object
+        (List.filterMap identity
+            [ Just ( "type", JE.string v.type_ )
+            , Just ( "module", JE.string v.module_ )
+            , Just ( "in", JE.int v.in_ )
+            , Just ( "if", JE.bool v.if_ )
+            , Just ( "exposing", (JE.list JE.string) v.exposing_ )
+            , Maybe.map (\x -> ( "as", encodeScalarsKeywords x )) v.as_
+            ]
+        )
+
+
+encodeScalarsEmpty : ScalarsEmpty -> JE.Value
+encodeScalarsEmpty _ =
+    JE.object []
+
+
+andMap : Decoder a -> Decoder (a -> b) -> Decoder b
+andMap =
+    map2 (|>)
+
+
+fieldWithDefault : String -> String -> a -> Decoder a -> Decoder a
+fieldWithDefault jsonName name default decoder =
+    optionalField jsonName name decoder
+        |> map (Maybe.withDefault default)
+
+
+int64 : Decoder String
+int64 =
+    oneOf [ string, map String.fromInt int ]
+
+
+oneOfFields : List (Decoder (Maybe a)) -> a -> Decoder a
+oneOfFields decoders notSet =
+    case decoders of
+        [] ->
+            succeed notSet
+
+        decoder :: rest ->
+            decoder
+                |> andThen
+                    (\member ->
+                        case member of
+                            Just m ->
+                                succeed m
+
+                            Nothing ->
+                                oneOfFields rest notSet
+                    )
+
+
+optionalField : String -> String -> Decoder a -> Decoder (Maybe a)
+optionalField jsonName name decoder =
+    value
+        |> andThen
+            (\json ->
+                case ( decodeValue (field jsonName value) json, decodeValue (field name value) json ) of
+                    ( Ok _, _ ) ->
+                        field jsonName (nullable decoder)
+
+                    ( _, Ok _ ) ->
+                        field name (nullable decoder)
+
+                    _ ->
+                        succeed Nothing
+            )
+
+
+requiredField : String -> String -> Decoder a -> Decoder a
+requiredField jsonName name decoder =
+    oneOf [ field jsonName decoder, field name decoder ]
@@ -0,0 +1,142 @@
+-- this is a generated file
+module Acme.Billing.Invoice exposing (..)
+import Json.Encode as JE
+import Json.Decode exposing (..)
+import Dict exposing (Dict)
+import Http
+import Url
+import Url.Builder
+import Acme.Shared.Shared as Shared
+
+type alias Invoice = {
+  invoice: Maybe Shared.Invoice,
+  currency: Shared.Currency,
+  payments: List Shared.Money,
+  adjustments: Dict String Shared.Money
+}
+
+type alias GetInvoiceRequest = {
+  id: String,
+  currency: Shared.Currency
+}
+
+
+invoiceDecoder : Decoder Invoice
+invoiceDecoder =
+    succeed Invoice
+        |> andMap (optionalField "invoice" "invoice" (lazy (\_ -> Shared.invoiceDecoder)))
+        |> andMap (fieldWithDefault "currency" "currency" (Shared.currencyFromString "CURRENCY_UNSPECIFIED") Shared.currencyDecoder)
+        |> andMap (fieldWithDefault "payments" "payments" [] (list (lazy (\_ -> Shared.moneyDecoder))))
+        |> andMap (fieldWithDefault "adjustments" "adjustments" Dict.empty (dict (lazy (\_ -> Shared.moneyDecoder))))
+
+
+getInvoiceRequestDecoder : Decoder GetInvoiceRequest
+getInvoiceRequestDecoder =
+    succeed GetInvoiceRequest
+        |> andMap (fieldWithDefault "id" "id" "" string)
+        |> andMap (fieldWithDefault "currency" "currency" (Shared.currencyFromString "CURRENCY_UNSPECIFIED") Shared.currencyDecoder)
+
+
+encodeInvoice : Invoice -> JE.Value
+encodeInvoice v =
+    JE.object
+        (List.filterMap identity
+            [ Maybe.map (\x -> ( "invoice", Shared.encodeInvoice x )) v.invoice
+            , Just ( "currency", Shared.encodeCurrency v.currency )
+            , Just ( "payments", (JE.list Shared.encodeMoney) v.payments )
+            , Just ( "adjustments", (JE.dict identity Shared.encodeMoney) v.adjustments )
+            ]
+        )
+
+
+encodeGetInvoiceRequest : GetInvoiceRequest -> JE.Value
+encodeGetInvoiceRequest v =
+    JE.object
+        (List.filterMap identity
+            [ Just ( "id", JE.string v.id )
+            , Just ( "currency", Shared.encodeCurrency v.currency )
+            ]
+        )
+
+
+billingGetInvoice : String -> (Result Http.Error Shared.Invoice -> msg) -> GetInvoiceRequest -> Cmd msg
+billingGetInvoice baseUrl toMsg request =
+    Http.request
+        { method = "GET"
+        , headers = []
+        , url = baseUrl ++ "/v1/invoices/" ++ Url.percentEncode request.id ++ Url.Builder.toQuery (List.concat [ [ (Url.Builder.string "currency" << Shared.currencyToString) request.currency ] ])
+        , body = Http.emptyBody
+        , expect = Http.expectJson toMsg (lazy (\_ -> Shared.invoiceDecoder))
+        , timeout = Nothing
+        , tracker = Nothing
+        }
+
+
+billingCreateInvoice : String -> (Result Http.Error Invoice -> msg) -> Shared.Invoice -> Cmd msg
+billingCreateInvoice baseUrl toMsg request =
+    Http.request
+        { method = "POST"
+        , headers = []
+        , url = baseUrl ++ "/v1/invoices"
+        , body = Http.jsonBody (Shared.encodeInvoice request)
+        , expect = Http.expectJson toMsg (lazy (\_ -> invoiceDecoder))
+        , timeout = Nothing
+        , tracker = Nothing
+        }
+
+
+andMap : Decoder a -> Decoder (a -> b) -> Decoder b
+andMap =
+    map2 (|>)
+
+
+fieldWithDefault : String -> String -> a -> Decoder a -> Decoder a
+fieldWithDefault jsonName name default decoder =
+    optionalField jsonName name decoder
+        |> map (Maybe.withDefault default)
+
+
+int64 : Decoder String
+int64 =
+    oneOf [ string, map String.fromInt int ]
+
+
+oneOfFields : List (Decoder (Maybe a)) -> a -> Decoder a
+oneOfFields decoders notSet =
+    case decoders of
+        [] ->
+            succeed notSet
+
+        decoder :: rest ->
+            decoder
+                |> andThen
+                    (\member ->
+                        case member of
+                            Just m ->
+                                succeed m
+
+                            Nothing ->
+                                oneOfFields rest notSet
+                    )
+
+
+optionalField : String -> String -> Decoder a -> Decoder (Maybe a)
+optionalField jsonName name decoder =
+    value
+        |> andThen
+            (\json ->
+                case ( decodeValue (field jsonName value) json, decodeValue (field name value) json ) of
+                    ( Ok _, _ ) ->
+                        field jsonName (nullable decoder)
+
+                    ( _, Ok _ ) ->
+                        field name (nullable decoder)
+
+                    _ ->
+                        succeed Nothing
+            )
+
+
+requiredField : String -> String -> Decoder a -> Decoder a
+requiredField jsonName name decoder =
+    oneOf [ field jsonName decoder, field name decoder ]
@@ -0,0 +1,171 @@
+-- this is a generated file
+module Acme.Shared.Shared exposing (..)
+import Json.Encode as JE
+import Json.Decode exposing (..)
+import Dict exposing (Dict)
+
+type Currency = CurrencyUnspecified
+    | CurrencyEur
+    | CurrencyUsd
+    | CurrencyUnrecognized String
+
+type alias Money = {
+  currency: Currency,
+  units: String
+}
+
+type alias Invoice = {
+  id: String,
+  total: Maybe Money
+}
+
+
+currencyFromString : String -> Currency
+currencyFromString s =
+    case s of
+        "CURRENCY_UNSPECIFIED" ->
+            CurrencyUnspecified
+
+        "CURRENCY_EUR" ->
+            CurrencyEur
+
+        "CURRENCY_USD" ->
+            CurrencyUsd
+
+        _ ->
+            CurrencyUnrecognized s
+
+
+currencyToString : Currency -> String
+currencyToString v =
+    case v of
+        CurrencyUnspecified ->
+            "CURRENCY_UNSPECIFIED"
+
+        CurrencyEur ->
+            "CURRENCY_EUR"
+
+        CurrencyUsd ->
+            "CURRENCY_USD"
+
+        CurrencyUnrecognized s ->
+            s
+
+
+currencyDecoder : Decoder Currency
+currencyDecoder =
+    oneOf
+        [ map currencyFromString string
+        , int
+            |> map
+                (\n ->
+                    case n of
+                        0 ->
+                            CurrencyUnspecified
+
+                        1 ->
+                            CurrencyEur
+
+                        2 ->
+                            CurrencyUsd
+
+                        _ ->
+                            CurrencyUnrecognized (String.fromInt n)
+                )
+        ]
+
+
+moneyDecoder : Decoder Money
+moneyDecoder =
+    succeed Money
+        |> andMap (fieldWithDefault "currency" "currency" (currencyFromString "CURRENCY_UNSPECIFIED") currencyDecoder)
+        |> andMap (fieldWithDefault "units" "units" "0" int64)
+
+
+invoiceDecoder : Decoder Invoice
+invoiceDecoder =
+    succeed Invoice
+        |> andMap (fieldWithDefault "id" "id" "" string)
+        |> andMap (optionalField "total" "total" (lazy (\_ -> moneyDecoder)))
+
+
+encodeCurrency : Currency -> JE.Value
+encodeCurrency v =
+    JE.string (currencyToString v)
+
+
+encodeMoney : Money -> JE.Value
+encodeMoney v =
+    JE.object
+        (List.filterMap identity
+            [ Just ( "currency", encodeCurrency v.currency )
+            , Just ( "units", JE.string v.units )
+            ]
+        )
+
+
+encodeInvoice : Invoice -> JE.Value
+encodeInvoice v =
+    JE.object
+        (List.filterMap identity
+            [ Just ( "id", JE.string v.id )
+            , Maybe.map (\x -> ( "total", encodeMoney x )) v.total
+            ]
+        )
+
+
+andMap : Decoder a -> Decoder (a -> b) -> Decoder b
+andMap =
+    map2 (|>)
+
+
+fieldWithDefault : String -> String -> a -> Decoder a -> Decoder a
+fieldWithDefault jsonName name default decoder =
+    optionalField jsonName name decoder
+        |> map (Maybe.withDefault default)
+
+
+int64 : Decoder String
+int64 =
+    oneOf [ string, map String.fromInt int ]
+
+
+oneOfFields : List (Decoder (Maybe a)) -> a -> Decoder a
+oneOfFields decoders notSet =
+    case decoders of
+        [] ->
+            succeed notSet
+
+        decoder :: rest ->
+            decoder
+                |> andThen
+                    (\member ->
+                        case member of
+                            Just m ->
+                                succeed m
+
+                            Nothing ->
+                                oneOfFields rest notSet
+                    )
+
+
+optionalField : String -> String -> Decoder a -> Decoder (Maybe a)
+optionalField jsonName name decoder =
+    value
+        |> andThen
+            (\json ->
+                case ( decodeValue (field jsonName value) json, decodeValue (field name value) json ) of
+                    ( Ok _, _ ) ->
+                        field jsonName (nullable decoder)
+
+                    ( _, Ok _ ) ->
+                        field name (nullable decoder)
+
+                    _ ->
+                        succeed Nothing
+            )
+
+
+requiredField : String -> String -> Decoder a -> Decoder a
+requiredField jsonName name decoder =
+    oneOf [ field jsonName decoder, field name decoder ]
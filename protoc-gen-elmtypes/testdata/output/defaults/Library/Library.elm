@@ -0,0 +1,418 @@
+-- this is a generated file
+module Library.Library exposing (..)
+import Json.Encode as JE
+import Json.Decode exposing (..)
+import Dict exposing (Dict)
+import Http
+import Url
+import Url.Builder
+
+type Genre = GenreUnspecified
+    | GenreFiction
+    | GenreHistory
+    | GenreUnrecognized String
+
+type alias Book = {
+  name: String,
+  title: String,
+  genre: Genre,
+  authors: List String
+}
+
+type alias Filter = {
+  title: String,
+  genres: List Genre,
+  available: Maybe Bool,
+  published_after: Maybe String
+}
+
+type alias GetBookRequest = {
+  name: String
+}
+
+type alias ListBooksRequest = {
+  shelf: Int,
+  page_size: Int,
+  page_token: String,
+  filter: Maybe Filter,
+  labels: Dict String String
+}
+
+type alias ListBooksResponse = {
+  books: List Book,
+  next_page_token: String
+}
+
+type alias CreateBookRequest = {
+  shelf: Int,
+  book: Maybe Book,
+  request_id: String
+}
+
+type alias DeleteBookRequest = {
+  name: String,
+  force: Bool
+}
+
+type alias MoveBookRequest = {
+  name: String,
+  genre: Genre
+}
+
+
+genreFromString : String -> Genre
+genreFromString s =
+    case s of
+        "GENRE_UNSPECIFIED" ->
+            GenreUnspecified
+
+        "GENRE_FICTION" ->
+            GenreFiction
+
+        "GENRE_HISTORY" ->
+            GenreHistory
+
+        _ ->
+            GenreUnrecognized s
+
+
+genreToString : Genre -> String
+genreToString v =
+    case v of
+        GenreUnspecified ->
+            "GENRE_UNSPECIFIED"
+
+        GenreFiction ->
+            "GENRE_FICTION"
+
+        GenreHistory ->
+            "GENRE_HISTORY"
+
+        GenreUnrecognized s ->
+            s
+
+
+genreDecoder : Decoder Genre
+genreDecoder =
+    oneOf
+        [ map genreFromString string
+        , int
+            |> map
+                (\n ->
+                    case n of
+                        0 ->
+                            GenreUnspecified
+
+                        1 ->
+                            GenreFiction
+
+                        2 ->
+                            GenreHistory
+
+                        _ ->
+                            GenreUnrecognized (String.fromInt n)
+                )
+        ]
+
+
+bookDecoder : Decoder Book
+bookDecoder =
+    succeed Book
+        |> andMap (fieldWithDefault "name" "name" "" string)
+        |> andMap (fieldWithDefault "title" "title" "" string)
+        |> andMap (fieldWithDefault "genre" "genre" (genreFromString "GENRE_UNSPECIFIED") genreDecoder)
+        |> andMap (fieldWithDefault "authors" "authors" [] (list string))
+
+
+filterDecoder : Decoder Filter
+filterDecoder =
+    succeed Filter
+        |> andMap (fieldWithDefault "title" "title" "" string)
+        |> andMap (fieldWithDefault "genres" "genres" [] (list genreDecoder))
+        |> andMap (optionalField "available" "available" bool)
+        |> andMap (optionalField "publishedAfter" "published_after" string)
+
+
+getBookRequestDecoder : Decoder GetBookRequest
+getBookRequestDecoder =
+    succeed GetBookRequest
+        |> andMap (fieldWithDefault "name" "name" "" string)
+
+
+listBooksRequestDecoder : Decoder ListBooksRequest
+listBooksRequestDecoder =
+    succeed ListBooksRequest
+        |> andMap (fieldWithDefault "shelf" "shelf" 0 int)
+        |> andMap (fieldWithDefault "pageSize" "page_size" 0 int)
+        |> andMap (fieldWithDefault "pageToken" "page_token" "" string)
+        |> andMap (optionalField "filter" "filter" (lazy (\_ -> filterDecoder)))
+        |> andMap (fieldWithDefault "labels" "labels" Dict.empty (dict string))
+
+
+listBooksResponseDecoder : Decoder ListBooksResponse
+listBooksResponseDecoder =
+    succeed ListBooksResponse
+        |> andMap (fieldWithDefault "books" "books" [] (list (lazy (\_ -> bookDecoder))))
+        |> andMap (fieldWithDefault "nextPageToken" "next_page_token" "" string)
+
+
+createBookRequestDecoder : Decoder CreateBookRequest
+createBookRequestDecoder =
+    succeed CreateBookRequest
+        |> andMap (fieldWithDefault "shelf" "shelf" 0 int)
+        |> andMap (optionalField "book" "book" (lazy (\_ -> bookDecoder)))
+        |> andMap (fieldWithDefault "requestId" "request_id" "" string)
+
+
+deleteBookRequestDecoder : Decoder DeleteBookRequest
+deleteBookRequestDecoder =
+    succeed DeleteBookRequest
+        |> andMap (fieldWithDefault "name" "name" "" string)
+        |> andMap (fieldWithDefault "force" "force" False bool)
+
+
+moveBookRequestDecoder : Decoder MoveBookRequest
+moveBookRequestDecoder =
+    succeed MoveBookRequest
+        |> andMap (fieldWithDefault "name" "name" "" string)
+        |> andMap (fieldWithDefault "genre" "genre" (genreFromString "GENRE_UNSPECIFIED") genreDecoder)
+
+
+encodeGenre : Genre -> JE.Value
+encodeGenre v =
+    JE.string (genreToString v)
+
+
+encodeBook : Book -> JE.Value
+encodeBook v =
+    JE.object
+        (List.filterMap identity
+            [ Just ( "name", JE.string v.name )
+            , Just ( "title", JE.string v.title )
+            , Just ( "genre", encodeGenre v.genre )
+            , Just ( "authors", (JE.list JE.string) v.authors )
+            ]
+        )
+
+
+encodeFilter : Filter -> JE.Value
+encodeFilter v =
+    JE.object
+        (List.filterMap identity
+            [ Just ( "title", JE.string v.title )
+            , Just ( "genres", (JE.list encodeGenre) v.genres )
+            , Maybe.map (\x -> ( "available", JE.bool x )) v.available
+            , Maybe.map (\x -> ( "publishedAfter", JE.string x )) v.published_after
+            ]
+        )
+
+
+encodeGetBookRequest : GetBookRequest -> JE.Value
+encodeGetBookRequest v =
+    JE.object
+        (List.filterMap identity
+            [ Just ( "name", JE.string v.name )
+            ]
+        )
+
+
+encodeListBooksRequest : ListBooksRequest -> JE.Value
+encodeListBooksRequest v =
+    JE.object
+        (List.filterMap identity
+            [ Just ( "shelf", JE.int v.shelf )
+            , Just ( "pageSize", JE.int v.page_size )
+            , Just ( "pageToken", JE.string v.page_token )
+            , Maybe.map (\x -> ( "filter", encodeFilter x )) v.filter
+            , Just ( "labels", (JE.dict identity JE.string) v.labels )
+            ]
+        )
+
+
+encodeListBooksResponse : ListBooksResponse -> JE.Value
+encodeListBooksResponse v =
+    JE.object
+        (List.filterMap identity
+            [ Just ( "books", (JE.list encodeBook) v.books )
+            , Just ( "nextPageToken", JE.string v.next_page_token )
+            ]
+        )
+
+
+encodeCreateBookRequest : CreateBookRequest -> JE.Value
+encodeCreateBookRequest v =
+    JE.object
+        (List.filterMap identity
+            [ Just ( "shelf", JE.int v.shelf )
+            , Maybe.map (\x -> ( "book", encodeBook x )) v.book
+            , Just ( "requestId", JE.string v.request_id )
+            ]
+        )
+
+
+encodeDeleteBookRequest : DeleteBookRequest -> JE.Value
+encodeDeleteBookRequest v =
+    JE.object
+        (List.filterMap identity
+            [ Just ( "name", JE.string v.name )
+            , Just ( "force", JE.bool v.force )
+            ]
+        )
+
+
+encodeMoveBookRequest : MoveBookRequest -> JE.Value
+encodeMoveBookRequest v =
+    JE.object
+        (List.filterMap identity
+            [ Just ( "name", JE.string v.name )
+            , Just ( "genre", encodeGenre v.genre )
+            ]
+        )
+
+
+libraryGetBook : String -> (Result Http.Error Book -> msg) -> GetBookRequest -> Cmd msg
+libraryGetBook baseUrl toMsg request =
+    Http.request
+        { method = "GET"
+        , headers = []
+        , url = baseUrl ++ "/v1/" ++ request.name
+        , body = Http.emptyBody
+        , expect = Http.expectJson toMsg (lazy (\_ -> bookDecoder))
+        , timeout = Nothing
+        , tracker = Nothing
+        }
+
+
+libraryListBooks : String -> (Result Http.Error ListBooksResponse -> msg) -> ListBooksRequest -> Cmd msg
+libraryListBooks baseUrl toMsg request =
+    Http.request
+        { method = "GET"
+        , headers = []
+        , url = baseUrl ++ "/v1/shelves/" ++ Url.percentEncode (String.fromInt request.shelf) ++ "/books" ++ Url.Builder.toQuery (List.concat [ [ (Url.Builder.string "page_size" << String.fromInt) request.page_size ], [ (Url.Builder.string "page_token") request.page_token ], (request.filter |> Maybe.map (\nested1 -> List.concat [ [ (Url.Builder.string "filter.title") nested1.title ], List.map (Url.Builder.string "filter.genres" << genreToString) nested1.genres, (nested1.available |> Maybe.map (List.singleton << (Url.Builder.string "filter.available" << (\b -> if b then "true" else "false"))) |> Maybe.withDefault []), (nested1.published_after |> Maybe.map (List.singleton << (Url.Builder.string "filter.published_after")) |> Maybe.withDefault []) ]) |> Maybe.withDefault []) ])
+        , body = Http.emptyBody
+        , expect = Http.expectJson toMsg (lazy (\_ -> listBooksResponseDecoder))
+        , timeout = Nothing
+        , tracker = Nothing
+        }
+
+
+libraryCreateBook : String -> (Result Http.Error Book -> msg) -> CreateBookRequest -> Cmd msg
+libraryCreateBook baseUrl toMsg request =
+    Http.request
+        { method = "POST"
+        , headers = []
+        , url = baseUrl ++ "/v1/shelves/" ++ Url.percentEncode (String.fromInt request.shelf) ++ "/books" ++ Url.Builder.toQuery (List.concat [ [ (Url.Builder.string "request_id") request.request_id ] ])
+        , body = Http.jsonBody (request.book |> Maybe.map encodeBook |> Maybe.withDefault JE.null)
+        , expect = Http.expectJson toMsg (lazy (\_ -> bookDecoder))
+        , timeout = Nothing
+        , tracker = Nothing
+        }
+
+
+libraryUpdateBook : String -> (Result Http.Error Book -> msg) -> Book -> Cmd msg
+libraryUpdateBook baseUrl toMsg request =
+    Http.request
+        { method = "PATCH"
+        , headers = []
+        , url = baseUrl ++ "/v1/" ++ request.name
+        , body = Http.jsonBody (encodeBook request)
+        , expect = Http.expectJson toMsg (lazy (\_ -> bookDecoder))
+        , timeout = Nothing
+        , tracker = Nothing
+        }
+
+
+libraryDeleteBook : String -> (Result Http.Error {} -> msg) -> DeleteBookRequest -> Cmd msg
+libraryDeleteBook baseUrl toMsg request =
+    Http.request
+        { method = "DELETE"
+        , headers = []
+        , url = baseUrl ++ "/v1/" ++ request.name ++ Url.Builder.toQuery (List.concat [ [ (Url.Builder.string "force" << (\b -> if b then "true" else "false")) request.force ] ])
+        , body = Http.emptyBody
+        , expect = Http.expectJson toMsg (succeed {})
+        , timeout = Nothing
+        , tracker = Nothing
+        }
+
+
+libraryMoveBook : String -> (Result Http.Error Book -> msg) -> MoveBookRequest -> Cmd msg
+libraryMoveBook baseUrl toMsg request =
+    Http.request
+        { method = "POST"
+        , headers = []
+        , url = baseUrl ++ "/v1/genres/" ++ Url.percentEncode (genreToString request.genre) ++ "/books" ++ Url.Builder.toQuery (List.concat [ [ (Url.Builder.string "name") request.name ] ])
+        , body = Http.emptyBody
+        , expect = Http.expectJson toMsg (lazy (\_ -> bookDecoder))
+        , timeout = Nothing
+        , tracker = Nothing
+        }
+
+
+libraryRecommendBook : String -> (Result Http.Error Book -> msg) -> {} -> Cmd msg
+libraryRecommendBook baseUrl toMsg request =
+    Http.request
+        { method = "POST"
+        , headers = []
+        , url = baseUrl ++ "/library.Library/RecommendBook"
+        , body = Http.jsonBody ((\_ -> JE.object []) request)
+        , expect = Http.expectJson toMsg (lazy (\_ -> bookDecoder))
+        , timeout = Nothing
+        , tracker = Nothing
+        }
+
+
+andMap : Decoder a -> Decoder (a -> b) -> Decoder b
+andMap =
+    map2 (|>)
+
+
+fieldWithDefault : String -> String -> a -> Decoder a -> Decoder a
+fieldWithDefault jsonName name default decoder =
+    optionalField jsonName name decoder
+        |> map (Maybe.withDefault default)
+
+
+int64 : Decoder String
+int64 =
+    oneOf [ string, map String.fromInt int ]
+
+
+oneOfFields : List (Decoder (Maybe a)) -> a -> Decoder a
+oneOfFields decoders notSet =
+    case decoders of
+        [] ->
+            succeed notSet
+
+        decoder :: rest ->
+            decoder
+                |> andThen
+                    (\member ->
+                        case member of
+                            Just m ->
+                                succeed m
+
+                            Nothing ->
+                                oneOfFields rest notSet
+                    )
+
+
+optionalField : String -> String -> Decoder a -> Decoder (Maybe a)
+optionalField jsonName name decoder =
+    value
+        |> andThen
+            (\json ->
+                case ( decodeValue (field jsonName value) json, decodeValue (field name value) json ) of
+                    ( Ok _, _ ) ->
+                        field jsonName (nullable decoder)
+
+                    ( _, Ok _ ) ->
+                        field name (nullable decoder)
+
+                    _ ->
+                        succeed Nothing
+            )
+
+
+requiredField : String -> String -> Decoder a -> Decoder a
+requiredField jsonName name decoder =
+    oneOf [ field jsonName decoder, field name decoder ]
@@ -0,0 +1,188 @@
+-- this is a generated file
+module Maps.Maps exposing (..)
+import Json.Encode as JE
+import Json.Decode exposing (..)
+import Dict exposing (Dict)
+
+type Level = LevelUnspecified
+    | LevelHigh
+    | LevelUnrecognized String
+
+type alias Item = {
+  name: String
+}
+
+type alias Inventory = {
+  counts: Dict String Int,
+  names: Dict String String,
+  items: Dict String Item,
+  levels: Dict String Level,
+  blobs: Dict String String,
+  total: String,
+  capacity: String,
+  delta: String,
+  checksum: String,
+  ids: List String,
+  data: String,
+  chunks: List String
+}
+
+
+levelFromString : String -> Level
+levelFromString s =
+    case s of
+        "LEVEL_UNSPECIFIED" ->
+            LevelUnspecified
+
+        "LEVEL_HIGH" ->
+            LevelHigh
+
+        _ ->
+            LevelUnrecognized s
+
+
+levelToString : Level -> String
+levelToString v =
+    case v of
+        LevelUnspecified ->
+            "LEVEL_UNSPECIFIED"
+
+        LevelHigh ->
+            "LEVEL_HIGH"
+
+        LevelUnrecognized s ->
+            s
+
+
+levelDecoder : Decoder Level
+levelDecoder =
+    oneOf
+        [ map levelFromString string
+        , int
+            |> map
+                (\n ->
+                    case n of
+                        0 ->
+                            LevelUnspecified
+
+                        1 ->
+                            LevelHigh
+
+                        _ ->
+                            LevelUnrecognized (String.fromInt n)
+                )
+        ]
+
+
+itemDecoder : Decoder Item
+itemDecoder =
+    succeed Item
+        |> andMap (fieldWithDefault "name" "name" "" string)
+
+
+inventoryDecoder : Decoder Inventory
+inventoryDecoder =
+    succeed Inventory
+        |> andMap (fieldWithDefault "counts" "counts" Dict.empty (dict int))
+        |> andMap (fieldWithDefault "names" "names" Dict.empty (dict string))
+        |> andMap (fieldWithDefault "items" "items" Dict.empty (dict (lazy (\_ -> itemDecoder))))
+        |> andMap (fieldWithDefault "levels" "levels" Dict.empty (dict levelDecoder))
+        |> andMap (fieldWithDefault "blobs" "blobs" Dict.empty (dict string))
+        |> andMap (fieldWithDefault "total" "total" "0" int64)
+        |> andMap (fieldWithDefault "capacity" "capacity" "0" int64)
+        |> andMap (fieldWithDefault "delta" "delta" "0" int64)
+        |> andMap (fieldWithDefault "checksum" "checksum" "0" int64)
+        |> andMap (fieldWithDefault "ids" "ids" [] (list int64))
+        |> andMap (fieldWithDefault "data" "data" "" string)
+        |> andMap (fieldWithDefault "chunks" "chunks" [] (list string))
+
+
+encodeLevel : Level -> JE.Value
+encodeLevel v =
+    JE.string (levelToString v)
+
+
+encodeItem : Item -> JE.Value
+encodeItem v =
+    JE.object
+        (List.filterMap identity
+            [ Just ( "name", JE.string v.name )
+            ]
+        )
+
+
+encodeInventory : Inventory -> JE.Value
+encodeInventory v =
+    JE.object
+        (List.filterMap identity
+            [ Just ( "counts", (JE.dict identity JE.int) v.counts )
+            , Just ( "names", (JE.dict identity JE.string) v.names )
+            , Just ( "items", (JE.dict identity encodeItem) v.items )
+            , Just ( "levels", (JE.dict identity encodeLevel) v.levels )
+            , Just ( "blobs", (JE.dict identity JE.string) v.blobs )
+            , Just ( "total", JE.string v.total )
+            , Just ( "capacity", JE.string v.capacity )
+            , Just ( "delta", JE.string v.delta )
+            , Just ( "checksum", JE.string v.checksum )
+            , Just ( "ids", (JE.list JE.string) v.ids )
+            , Just ( "data", JE.string v.data )
+            , Just ( "chunks", (JE.list JE.string) v.chunks )
+            ]
+        )
+
+
+andMap : Decoder a -> Decoder (a -> b) -> Decoder b
+andMap =
+    map2 (|>)
+
+
+fieldWithDefault : String -> String -> a -> Decoder a -> Decoder a
+fieldWithDefault jsonName name default decoder =
+    optionalField jsonName name decoder
+        |> map (Maybe.withDefault default)
+
+
+int64 : Decoder String
+int64 =
+    oneOf [ string, map String.fromInt int ]
+
+
+oneOfFields : List (Decoder (Maybe a)) -> a -> Decoder a
+oneOfFields decoders notSet =
+    case decoders of
+        [] ->
+            succeed notSet
+
+        decoder :: rest ->
+            decoder
+                |> andThen
+                    (\member ->
+                        case member of
+                            Just m ->
+                                succeed m
+
+                            Nothing ->
+                                oneOfFields rest notSet
+                    )
+
+
+optionalField : String -> String -> Decoder a -> Decoder (Maybe a)
+optionalField jsonName name decoder =
+    value
+        |> andThen
+            (\json ->
+                case ( decodeValue (field jsonName value) json, decodeValue (field name value) json ) of
+                    ( Ok _, _ ) ->
+                        field jsonName (nullable decoder)
+
+                    ( _, Ok _ ) ->
+                        field name (nullable decoder)
+
+                    _ ->
+                        succeed Nothing
+            )
+
+
+requiredField : String -> String -> Decoder a -> Decoder a
+requiredField jsonName name decoder =
+    oneOf [ field jsonName decoder, field name decoder ]
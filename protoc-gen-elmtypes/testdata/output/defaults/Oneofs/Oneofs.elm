@@ -0,0 +1,251 @@
+-- this is a generated file
+module Oneofs.Oneofs exposing (..)
+import Json.Encode as JE
+import Json.Decode exposing (..)
+import Dict exposing (Dict)
+
+type alias Image = {
+  url: String
+}
+
+type alias Post = {
+  id: String,
+  content: PostContentOneof,
+  audience: PostAudienceOneof
+}
+
+type PostContentOneof = PostContentOneofText String
+    | PostContentOneofImage Image
+    | PostContentOneofNotSet
+
+type PostAudienceOneof = PostAudienceOneofPublic Bool
+    | PostAudienceOneofGroupId String
+    | PostAudienceOneofNotSet
+
+type alias Invoice = {
+  payment: InvoicePaymentOneof,
+  type_: InvoiceTypeOneof
+}
+
+type InvoicePaymentOneof = InvoicePaymentOneofCard String
+    | InvoicePaymentOneofTransfer InvoicePayment
+    | InvoicePaymentOneofNotSet
+
+type InvoiceTypeOneof = InvoiceTypeOneofNumber String
+    | InvoiceTypeOneofCode String
+    | InvoiceTypeOneofNotSet
+
+type alias InvoicePayment = {
+  reference: String
+}
+
+
+imageDecoder : Decoder Image
+imageDecoder =
+    succeed Image
+        |> andMap (fieldWithDefault "url" "url" "" string)
+
+
+postDecoder : Decoder Post
+postDecoder =
+    succeed Post
+        |> andMap (fieldWithDefault "id" "id" "" string)
+        |> andMap postContentOneofDecoder
+        |> andMap postAudienceOneofDecoder
+
+
+postContentOneofDecoder : Decoder PostContentOneof
+postContentOneofDecoder =
+    oneOfFields
+        [ map (Maybe.map PostContentOneofText) (optionalField "text" "text" string)
+        , map (Maybe.map PostContentOneofImage) (optionalField "image" "image" (lazy (\_ -> imageDecoder)))
+        ]
+        PostContentOneofNotSet
+
+
+postAudienceOneofDecoder : Decoder PostAudienceOneof
+postAudienceOneofDecoder =
+    oneOfFields
+        [ map (Maybe.map PostAudienceOneofPublic) (optionalField "public" "public" bool)
+        , map (Maybe.map PostAudienceOneofGroupId) (optionalField "groupId" "group_id" string)
+        ]
+        PostAudienceOneofNotSet
+
+
+invoiceDecoder : Decoder Invoice
+invoiceDecoder =
+    succeed Invoice
+        |> andMap invoicePaymentOneofDecoder
+        |> andMap invoiceTypeOneofDecoder
+
+
+invoicePaymentOneofDecoder : Decoder InvoicePaymentOneof
+invoicePaymentOneofDecoder =
+    oneOfFields
+        [ map (Maybe.map InvoicePaymentOneofCard) (optionalField "card" "card" string)
+        , map (Maybe.map InvoicePaymentOneofTransfer) (optionalField "transfer" "transfer" (lazy (\_ -> invoicePaymentDecoder)))
+        ]
+        InvoicePaymentOneofNotSet
+
+
+invoiceTypeOneofDecoder : Decoder InvoiceTypeOneof
+invoiceTypeOneofDecoder =
+    oneOfFields
+        [ map (Maybe.map InvoiceTypeOneofNumber) (optionalField "number" "number" int64)
+        , map (Maybe.map InvoiceTypeOneofCode) (optionalField "code" "code" string)
+        ]
+        InvoiceTypeOneofNotSet
+
+
+invoicePaymentDecoder : Decoder InvoicePayment
+invoicePaymentDecoder =
+    succeed InvoicePayment
+        |> andMap (fieldWithDefault "reference" "reference" "" string)
+
+
+encodeImage : Image -> JE.Value
+encodeImage v =
+    JE.object
+        (List.filterMap identity
+            [ Just ( "url", JE.string v.url )
+            ]
+        )
+
+
+encodePost : Post -> JE.Value
+encodePost v =
+    JE.object
+        (List.filterMap identity
+            [ Just ( "id", JE.string v.id )
+            , encodePostContentOneof v.content
+            , encodePostAudienceOneof v.audience
+            ]
+        )
+
+
+encodePostContentOneof : PostContentOneof -> Maybe ( String, JE.Value )
+encodePostContentOneof v =
+    case v of
+        PostContentOneofText x ->
+            Just ( "text", JE.string x )
+
+        PostContentOneofImage x ->
+            Just ( "image", encodeImage x )
+
+        PostContentOneofNotSet ->
+            Nothing
+
+
+encodePostAudienceOneof : PostAudienceOneof -> Maybe ( String, JE.Value )
+encodePostAudienceOneof v =
+    case v of
+        PostAudienceOneofPublic x ->
+            Just ( "public", JE.bool x )
+
+        PostAudienceOneofGroupId x ->
+            Just ( "groupId", JE.string x )
+
+        PostAudienceOneofNotSet ->
+            Nothing
+
+
+encodeInvoice : Invoice -> JE.Value
+encodeInvoice v =
+    JE.object
+        (List.filterMap identity
+            [ encodeInvoicePaymentOneof v.payment
+            , encodeInvoiceTypeOneof v.type_
+            ]
+        )
+
+
+encodeInvoicePaymentOneof : InvoicePaymentOneof -> Maybe ( String, JE.Value )
+encodeInvoicePaymentOneof v =
+    case v of
+        InvoicePaymentOneofCard x ->
+            Just ( "card", JE.string x )
+
+        InvoicePaymentOneofTransfer x ->
+            Just ( "transfer", encodeInvoicePayment x )
+
+        InvoicePaymentOneofNotSet ->
+            Nothing
+
+
+encodeInvoiceTypeOneof : InvoiceTypeOneof -> Maybe ( String, JE.Value )
+encodeInvoiceTypeOneof v =
+    case v of
+        InvoiceTypeOneofNumber x ->
+            Just ( "number", JE.string x )
+
+        InvoiceTypeOneofCode x ->
+            Just ( "code", JE.string x )
+
+        InvoiceTypeOneofNotSet ->
+            Nothing
+
+
+encodeInvoicePayment : InvoicePayment -> JE.Value
+encodeInvoicePayment v =
+    JE.object
+        (List.filterMap identity
+            [ Just ( "reference", JE.string v.reference )
+            ]
+        )
+
+
+andMap : Decoder a -> Decoder (a -> b) -> Decoder b
+andMap =
+    map2 (|>)
+
+
+fieldWithDefault : String -> String -> a -> Decoder a -> Decoder a
+fieldWithDefault jsonName name default decoder =
+    optionalField jsonName name decoder
+        |> map (Maybe.withDefault default)
+
+
+int64 : Decoder String
+int64 =
+    oneOf [ string, map String.fromInt int ]
+
+
+oneOfFields : List (Decoder (Maybe a)) -> a -> Decoder a
+oneOfFields decoders notSet =
+    case decoders of
+        [] ->
+            succeed notSet
+
+        decoder :: rest ->
+            decoder
+                |> andThen
+                    (\member ->
+                        case member of
+                            Just m ->
+                                succeed m
+
+                            Nothing ->
+                                oneOfFields rest notSet
+                    )
+
+
+optionalField : String -> String -> Decoder a -> Decoder (Maybe a)
+optionalField jsonName name decoder =
+    value
+        |> andThen
+            (\json ->
+                case ( decodeValue (field jsonName value) json, decodeValue (field name value) json ) of
+                    ( Ok _, _ ) ->
+                        field jsonName (nullable decoder)
+
+                    ( _, Ok _ ) ->
+                        field name (nullable decoder)
+
+                    _ ->
+                        succeed Nothing
+            )
+
+
+requiredField : String -> String -> Decoder a -> Decoder a
+requiredField jsonName name decoder =
+    oneOf [ field jsonName decoder, field name decoder ]
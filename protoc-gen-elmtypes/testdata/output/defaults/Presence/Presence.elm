@@ -0,0 +1,204 @@
+-- this is a generated file
+module Presence.Presence exposing (..)
+import Json.Encode as JE
+import Json.Decode exposing (..)
+import Dict exposing (Dict)
+
+type Color = ColorUnspecified
+    | ColorRed
+    | ColorUnrecognized String
+
+type alias Item = {
+  name: String
+}
+
+type alias Presence = {
+  name: String,
+  nickname: Maybe String,
+  count: Maybe String,
+  color: Maybe Color,
+  item: Maybe Item,
+  required_item: Item,
+  annotated_item: Item,
+  required_nickname: String,
+  items: List Item
+}
+
+type alias Required = {
+  item: Item,
+  note: String,
+  optional_item: Maybe Item
+}
+
+
+colorFromString : String -> Color
+colorFromString s =
+    case s of
+        "COLOR_UNSPECIFIED" ->
+            ColorUnspecified
+
+        "COLOR_RED" ->
+            ColorRed
+
+        _ ->
+            ColorUnrecognized s
+
+
+colorToString : Color -> String
+colorToString v =
+    case v of
+        ColorUnspecified ->
+            "COLOR_UNSPECIFIED"
+
+        ColorRed ->
+            "COLOR_RED"
+
+        ColorUnrecognized s ->
+            s
+
+
+colorDecoder : Decoder Color
+colorDecoder =
+    oneOf
+        [ map colorFromString string
+        , int
+            |> map
+                (\n ->
+                    case n of
+                        0 ->
+                            ColorUnspecified
+
+                        1 ->
+                            ColorRed
+
+                        _ ->
+                            ColorUnrecognized (String.fromInt n)
+                )
+        ]
+
+
+itemDecoder : Decoder Item
+itemDecoder =
+    succeed Item
+        |> andMap (fieldWithDefault "name" "name" "" string)
+
+
+presenceDecoder : Decoder Presence
+presenceDecoder =
+    succeed Presence
+        |> andMap (fieldWithDefault "name" "name" "" string)
+        |> andMap (optionalField "nickname" "nickname" string)
+        |> andMap (optionalField "count" "count" int64)
+        |> andMap (optionalField "color" "color" colorDecoder)
+        |> andMap (optionalField "item" "item" (lazy (\_ -> itemDecoder)))
+        |> andMap (requiredField "requiredItem" "required_item" (lazy (\_ -> itemDecoder)))
+        |> andMap (requiredField "annotatedItem" "annotated_item" (lazy (\_ -> itemDecoder)))
+        |> andMap (requiredField "requiredNickname" "required_nickname" string)
+        |> andMap (fieldWithDefault "items" "items" [] (list (lazy (\_ -> itemDecoder))))
+
+
+requiredDecoder : Decoder Required
+requiredDecoder =
+    succeed Required
+        |> andMap (requiredField "item" "item" (lazy (\_ -> itemDecoder)))
+        |> andMap (requiredField "note" "note" string)
+        |> andMap (optionalField "optionalItem" "optional_item" (lazy (\_ -> itemDecoder)))
+
+
+encodeColor : Color -> JE.Value
+encodeColor v =
+    JE.string (colorToString v)
+
+
+encodeItem : Item -> JE.Value
+encodeItem v =
+    JE.object
+        (List.filterMap identity
+            [ Just ( "name", JE.string v.name )
+            ]
+        )
+
+
+encodePresence : Presence -> JE.Value
+encodePresence v =
+    JE.object
+        (List.filterMap identity
+            [ Just ( "name", JE.string v.name )
+            , Maybe.map (\x -> ( "nickname", JE.string x )) v.nickname
+            , Maybe.map (\x -> ( "count", JE.string x )) v.count
+            , Maybe.map (\x -> ( "color", encodeColor x )) v.color
+            , Maybe.map (\x -> ( "item", encodeItem x )) v.item
+            , Just ( "requiredItem", encodeItem v.required_item )
+            , Just ( "annotatedItem", encodeItem v.annotated_item )
+            , Just ( "requiredNickname", JE.string v.required_nickname )
+            , Just ( "items", (JE.list encodeItem) v.items )
+            ]
+        )
+
+
+encodeRequired : Required -> JE.Value
+encodeRequired v =
+    JE.object
+        (List.filterMap identity
+            [ Just ( "item", encodeItem v.item )
+            , Just ( "note", JE.string v.note )
+            , Maybe.map (\x -> ( "optionalItem", encodeItem x )) v.optional_item
+            ]
+        )
+
+
+andMap : Decoder a -> Decoder (a -> b) -> Decoder b
+andMap =
+    map2 (|>)
+
+
+fieldWithDefault : String -> String -> a -> Decoder a -> Decoder a
+fieldWithDefault jsonName name default decoder =
+    optionalField jsonName name decoder
+        |> map (Maybe.withDefault default)
+
+
+int64 : Decoder String
+int64 =
+    oneOf [ string, map String.fromInt int ]
+
+
+oneOfFields : List (Decoder (Maybe a)) -> a -> Decoder a
+oneOfFields decoders notSet =
+    case decoders of
+        [] ->
+            succeed notSet
+
+        decoder :: rest ->
+            decoder
+                |> andThen
+                    (\member ->
+                        case member of
+                            Just m ->
+                                succeed m
+
+                            Nothing ->
+                                oneOfFields rest notSet
+                    )
+
+
+optionalField : String -> String -> Decoder a -> Decoder (Maybe a)
+optionalField jsonName name decoder =
+    value
+        |> andThen
+            (\json ->
+                case ( decodeValue (field jsonName value) json, decodeValue (field name value) json ) of
+                    ( Ok _, _ ) ->
+                        field jsonName (nullable decoder)
+
+                    ( _, Ok _ ) ->
+                        field name (nullable decoder)
+
+                    _ ->
+                        succeed Nothing
+            )
+
+
+requiredField : String -> String -> Decoder a -> Decoder a
+requiredField jsonName name decoder =
+    oneOf [ field jsonName decoder, field name decoder ]
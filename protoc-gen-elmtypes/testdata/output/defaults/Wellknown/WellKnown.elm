@@ -0,0 +1,126 @@
+-- this is a generated file
+module Wellknown.WellKnown exposing (..)
+import Json.Encode as JE
+import Json.Decode exposing (..)
+import Dict exposing (Dict)
+
+type alias Event = {
+  created_at: Maybe String,
+  ttl: Maybe String,
+  update_mask: Maybe String,
+  attributes: Maybe JE.Value,
+  payload: Maybe JE.Value,
+  items: Maybe JE.Value,
+  details: Maybe JE.Value,
+  nothing: (),
+  empty: Maybe {},
+  double_wrapper: Maybe Float,
+  int64_wrapper: Maybe String,
+  uint32_wrapper: Maybe Int,
+  bool_wrapper: Maybe Bool,
+  string_wrapper: Maybe String,
+  bytes_wrapper: Maybe String,
+  history: List String
+}
+
+
+eventDecoder : Decoder Event
+eventDecoder =
+    succeed Event
+        |> andMap (optionalField "createdAt" "created_at" string)
+        |> andMap (optionalField "ttl" "ttl" string)
+        |> andMap (optionalField "updateMask" "update_mask" string)
+        |> andMap (optionalField "attributes" "attributes" value)
+        |> andMap (optionalField "payload" "payload" value)
+        |> andMap (optionalField "items" "items" value)
+        |> andMap (optionalField "details" "details" value)
+        |> andMap (fieldWithDefault "nothing" "nothing" () (null ()))
+        |> andMap (optionalField "empty" "empty" (succeed {}))
+        |> andMap (optionalField "doubleWrapper" "double_wrapper" float)
+        |> andMap (optionalField "int64Wrapper" "int64_wrapper" int64)
+        |> andMap (optionalField "uint32Wrapper" "uint32_wrapper" int)
+        |> andMap (optionalField "boolWrapper" "bool_wrapper" bool)
+        |> andMap (optionalField "stringWrapper" "string_wrapper" string)
+        |> andMap (optionalField "bytesWrapper" "bytes_wrapper" string)
+        |> andMap (fieldWithDefault "history" "history" [] (list string))
+
+
+encodeEvent : Event -> JE.Value
+encodeEvent v =
+    JE.object
+        (List.filterMap identity
+            [ Maybe.map (\x -> ( "createdAt", JE.string x )) v.created_at
+            , Maybe.map (\x -> ( "ttl", JE.string x )) v.ttl
+            , Maybe.map (\x -> ( "updateMask", JE.string x )) v.update_mask
+            , Maybe.map (\x -> ( "attributes", identity x )) v.attributes
+            , Maybe.map (\x -> ( "payload", identity x )) v.payload
+            , Maybe.map (\x -> ( "items", identity x )) v.items
+            , Maybe.map (\x -> ( "details", identity x )) v.details
+            , Just ( "nothing", (\_ -> JE.null) v.nothing )
+            , Maybe.map (\x -> ( "empty", (\_ -> JE.object []) x )) v.empty
+            , Maybe.map (\x -> ( "doubleWrapper", JE.float x )) v.double_wrapper
+            , Maybe.map (\x -> ( "int64Wrapper", JE.string x )) v.int64_wrapper
+            , Maybe.map (\x -> ( "uint32Wrapper", JE.int x )) v.uint32_wrapper
+            , Maybe.map (\x -> ( "boolWrapper", JE.bool x )) v.bool_wrapper
+            , Maybe.map (\x -> ( "stringWrapper", JE.string x )) v.string_wrapper
+            , Maybe.map (\x -> ( "bytesWrapper", JE.string x )) v.bytes_wrapper
+            , Just ( "history", (JE.list JE.string) v.history )
+            ]
+        )
+
+
+andMap : Decoder a -> Decoder (a -> b) -> Decoder b
+andMap =
+    map2 (|>)
+
+
+fieldWithDefault : String -> String -> a -> Decoder a -> Decoder a
+fieldWithDefault jsonName name default decoder =
+    optionalField jsonName name decoder
+        |> map (Maybe.withDefault default)
+
+
+int64 : Decoder String
+int64 =
+    oneOf [ string, map String.fromInt int ]
+
+
+oneOfFields : List (Decoder (Maybe a)) -> a -> Decoder a
+oneOfFields decoders notSet =
+    case decoders of
+        [] ->
+            succeed notSet
+
+        decoder :: rest ->
+            decoder
+                |> andThen
+                    (\member ->
+                        case member of
+                            Just m ->
+                                succeed m
+
+                            Nothing ->
+                                oneOfFields rest notSet
+                    )
+
+
+optionalField : String -> String -> Decoder a -> Decoder (Maybe a)
+optionalField jsonName name decoder =
+    value
+        |> andThen
+            (\json ->
+                case ( decodeValue (field jsonName value) json, decodeValue (field name value) json ) of
+                    ( Ok _, _ ) ->
+                        field jsonName (nullable decoder)
+
+                    ( _, Ok _ ) ->
+                        field name (nullable decoder)
+
+                    _ ->
+                        succeed Nothing
+            )
+
+
+requiredField : String -> String -> Decoder a -> Decoder a
+requiredField jsonName name decoder =
+    oneOf [ field jsonName decoder, field name decoder ]
@@ -0,0 +1,292 @@
+-- this is a generated file
+module Proto.Enums.Enums exposing (..)
+import Json.Encode as JE
+import Json.Decode exposing (..)
+import Dict exposing (Dict)
+
+type TaskKind = TaskKindUnspecified
+    | TaskKindBug
+    | TaskKindFeature
+    | TaskKindUnrecognized String
+
+type Color = ColorUnspecified
+    | ColorRed
+    | ColorGreen
+    | ColorUnrecognized String
+
+type Status = StatusUnknown
+    | StatusStarted
+    | StatusRunning
+    | StatusDone
+    | StatusUnrecognized String
+
+type alias Task = {
+  kind: TaskKind,
+  color: Color,
+  status: Status,
+  labels: List Color
+}
+
+
+taskKindFromString : String -> TaskKind
+taskKindFromString s =
+    case s of
+        "KIND_UNSPECIFIED" ->
+            TaskKindUnspecified
+
+        "BUG" ->
+            TaskKindBug
+
+        "FEATURE" ->
+            TaskKindFeature
+
+        _ ->
+            TaskKindUnrecognized s
+
+
+taskKindToString : TaskKind -> String
+taskKindToString v =
+    case v of
+        TaskKindUnspecified ->
+            "KIND_UNSPECIFIED"
+
+        TaskKindBug ->
+            "BUG"
+
+        TaskKindFeature ->
+            "FEATURE"
+
+        TaskKindUnrecognized s ->
+            s
+
+
+taskKindDecoder : Decoder TaskKind
+taskKindDecoder =
+    oneOf
+        [ map taskKindFromString string
+        , int
+            |> map
+                (\n ->
+                    case n of
+                        0 ->
+                            TaskKindUnspecified
+
+                        1 ->
+                            TaskKindBug
+
+                        2 ->
+                            TaskKindFeature
+
+                        _ ->
+                            TaskKindUnrecognized (String.fromInt n)
+                )
+        ]
+
+
+colorFromString : String -> Color
+colorFromString s =
+    case s of
+        "COLOR_UNSPECIFIED" ->
+            ColorUnspecified
+
+        "COLOR_RED" ->
+            ColorRed
+
+        "COLOR_GREEN" ->
+            ColorGreen
+
+        _ ->
+            ColorUnrecognized s
+
+
+colorToString : Color -> String
+colorToString v =
+    case v of
+        ColorUnspecified ->
+            "COLOR_UNSPECIFIED"
+
+        ColorRed ->
+            "COLOR_RED"
+
+        ColorGreen ->
+            "COLOR_GREEN"
+
+        ColorUnrecognized s ->
+            s
+
+
+colorDecoder : Decoder Color
+colorDecoder =
+    oneOf
+        [ map colorFromString string
+        , int
+            |> map
+                (\n ->
+                    case n of
+                        0 ->
+                            ColorUnspecified
+
+                        1 ->
+                            ColorRed
+
+                        2 ->
+                            ColorGreen
+
+                        _ ->
+                            ColorUnrecognized (String.fromInt n)
+                )
+        ]
+
+
+statusFromString : String -> Status
+statusFromString s =
+    case s of
+        "UNKNOWN" ->
+            StatusUnknown
+
+        "STARTED" ->
+            StatusStarted
+
+        "RUNNING" ->
+            StatusRunning
+
+        "DONE" ->
+            StatusDone
+
+        _ ->
+            StatusUnrecognized s
+
+
+statusToString : Status -> String
+statusToString v =
+    case v of
+        StatusUnknown ->
+            "UNKNOWN"
+
+        StatusStarted ->
+            "STARTED"
+
+        StatusRunning ->
+            "RUNNING"
+
+        StatusDone ->
+            "DONE"
+
+        StatusUnrecognized s ->
+            s
+
+
+statusDecoder : Decoder Status
+statusDecoder =
+    oneOf
+        [ map statusFromString string
+        , int
+            |> map
+                (\n ->
+                    case n of
+                        0 ->
+                            StatusUnknown
+
+                        1 ->
+                            StatusStarted
+
+                        2 ->
+                            StatusDone
+
+                        _ ->
+                            StatusUnrecognized (String.fromInt n)
+                )
+        ]
+
+
+taskDecoder : Decoder Task
+taskDecoder =
+    succeed Task
+        |> andMap (fieldWithDefault "kind" "kind" (taskKindFromString "KIND_UNSPECIFIED") taskKindDecoder)
+        |> andMap (fieldWithDefault "color" "color" (colorFromString "COLOR_UNSPECIFIED") colorDecoder)
+        |> andMap (fieldWithDefault "status" "status" (statusFromString "UNKNOWN") statusDecoder)
+        |> andMap (fieldWithDefault "labels" "labels" [] (list colorDecoder))
+
+
+encodeTaskKind : TaskKind -> JE.Value
+encodeTaskKind v =
+    JE.string (taskKindToString v)
+
+
+encodeColor : Color -> JE.Value
+encodeColor v =
+    JE.string (colorToString v)
+
+
+encodeStatus : Status -> JE.Value
+encodeStatus v =
+    JE.string (statusToString v)
+
+
+encodeTask : Task -> JE.Value
+encodeTask v =
+    JE.object
+        (List.filterMap identity
+            [ Just ( "kind", encodeTaskKind v.kind )
+            , Just ( "color", encodeColor v.color )
+            , Just ( "status", encodeStatus v.status )
+            , Just ( "labels", (JE.list encodeColor) v.labels )
+            ]
+        )
+
+
+andMap : Decoder a -> Decoder (a -> b) -> Decoder b
+andMap =
+    map2 (|>)
+
+
+fieldWithDefault : String -> String -> a -> Decoder a -> Decoder a
+fieldWithDefault jsonName name default decoder =
+    optionalField jsonName name decoder
+        |> map (Maybe.withDefault default)
+
+
+int64 : Decoder String
+int64 =
+    oneOf [ string, map String.fromInt int ]
+
+
+oneOfFields : List (Decoder (Maybe a)) -> a -> Decoder a
+oneOfFields decoders notSet =
+    case decoders of
+        [] ->
+            succeed notSet
+
+        decoder :: rest ->
+            decoder
+                |> andThen
+                    (\member ->
+                        case member of
+                            Just m ->
+                                succeed m
+
+                            Nothing ->
+                                oneOfFields rest notSet
+                    )
+
+
+optionalField : String -> String -> Decoder a -> Decoder (Maybe a)
+optionalField jsonName name decoder =
+    value
+        |> andThen
+            (\json ->
+                case ( decodeValue (field jsonName value) json, decodeValue (field name value) json ) of
+                    ( Ok _, _ ) ->
+                        field jsonName (nullable decoder)
+
+                    ( _, Ok _ ) ->
+                        field name (nullable decoder)
+
+                    _ ->
+                        succeed Nothing
+            )
+
+
+requiredField : String -> String -> Decoder a -> Decoder a
+requiredField jsonName name decoder =
+    oneOf [ field jsonName decoder, field name decoder ]
@@ -0,0 +1,173 @@
+-- this is a generated file
+module Proto.Presence2.Presence2 exposing (..)
+import Json.Encode as JE
+import Json.Decode exposing (..)
+import Dict exposing (Dict)
+
+type Priority = PriorityLow
+    | PriorityHigh
+    | PriorityUnrecognized String
+
+type alias Task = {
+  id: String,
+  title: Maybe String,
+  estimate: Maybe Int,
+  priority: Maybe Priority,
+  required_priority: Priority,
+  tags: List String,
+  details: Maybe TaskDetails
+}
+
+type alias TaskDetails = {
+  description: Maybe String
+}
+
+
+priorityFromString : String -> Priority
+priorityFromString s =
+    case s of
+        "LOW" ->
+            PriorityLow
+
+        "HIGH" ->
+            PriorityHigh
+
+        _ ->
+            PriorityUnrecognized s
+
+
+priorityToString : Priority -> String
+priorityToString v =
+    case v of
+        PriorityLow ->
+            "LOW"
+
+        PriorityHigh ->
+            "HIGH"
+
+        PriorityUnrecognized s ->
+            s
+
+
+priorityDecoder : Decoder Priority
+priorityDecoder =
+    oneOf
+        [ map priorityFromString string
+        , int
+            |> map
+                (\n ->
+                    case n of
+                        1 ->
+                            PriorityLow
+
+                        2 ->
+                            PriorityHigh
+
+                        _ ->
+                            PriorityUnrecognized (String.fromInt n)
+                )
+        ]
+
+
+taskDecoder : Decoder Task
+taskDecoder =
+    succeed Task
+        |> andMap (requiredField "id" "id" string)
+        |> andMap (optionalField "title" "title" string)
+        |> andMap (optionalField "estimate" "estimate" int)
+        |> andMap (optionalField "priority" "priority" priorityDecoder)
+        |> andMap (requiredField "requiredPriority" "required_priority" priorityDecoder)
+        |> andMap (fieldWithDefault "tags" "tags" [] (list string))
+        |> andMap (optionalField "details" "details" (lazy (\_ -> taskDetailsDecoder)))
+
+
+taskDetailsDecoder : Decoder TaskDetails
+taskDetailsDecoder =
+    succeed TaskDetails
+        |> andMap (optionalField "description" "description" string)
+
+
+encodePriority : Priority -> JE.Value
+encodePriority v =
+    JE.string (priorityToString v)
+
+
+encodeTask : Task -> JE.Value
+encodeTask v =
+    JE.object
+        (List.filterMap identity
+            [ Just ( "id", JE.string v.id )
+            , Maybe.map (\x -> ( "title", JE.string x )) v.title
+            , Maybe.map (\x -> ( "estimate", JE.int x )) v.estimate
+            , Maybe.map (\x -> ( "priority", encodePriority x )) v.priority
+            , Just ( "requiredPriority", encodePriority v.required_priority )
+            , Just ( "tags", (JE.list JE.string) v.tags )
+            , Maybe.map (\x -> ( "details", encodeTaskDetails x )) v.details
+            ]
+        )
+
+
+encodeTaskDetails : TaskDetails -> JE.Value
+encodeTaskDetails v =
+    JE.object
+        (List.filterMap identity
+            [ Maybe.map (\x -> ( "description", JE.string x )) v.description
+            ]
+        )
+
+
+andMap : Decoder a -> Decoder (a -> b) -> Decoder b
+andMap =
+    map2 (|>)
+
+
+fieldWithDefault : String -> String -> a -> Decoder a -> Decoder a
+fieldWithDefault jsonName name default decoder =
+    optionalField jsonName name decoder
+        |> map (Maybe.withDefault default)
+
+
+int64 : Decoder String
+int64 =
+    oneOf [ string, map String.fromInt int ]
+
+
+oneOfFields : List (Decoder (Maybe a)) -> a -> Decoder a
+oneOfFields decoders notSet =
+    case decoders of
+        [] ->
+            succeed notSet
+
+        decoder :: rest ->
+            decoder
+                |> andThen
+                    (\member ->
+                        case member of
+                            Just m ->
+                                succeed m
+
+                            Nothing ->
+                                oneOfFields rest notSet
+                    )
+
+
+optionalField : String -> String -> Decoder a -> Decoder (Maybe a)
+optionalField jsonName name decoder =
+    value
+        |> andThen
+            (\json ->
+                case ( decodeValue (field jsonName value) json, decodeValue (field name value) json ) of
+                    ( Ok _, _ ) ->
+                        field jsonName (nullable decoder)
+
+                    ( _, Ok _ ) ->
+                        field name (nullable decoder)
+
+                    _ ->
+                        succeed Nothing
+            )
+
+
+requiredField : String -> String -> Decoder a -> Decoder a
+requiredField jsonName name decoder =
+    oneOf [ field jsonName decoder, field name decoder ]
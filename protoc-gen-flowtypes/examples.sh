@@ -0,0 +1,30 @@
+#!/bin/bash
+set -euo pipefail
+set -x
+
+cd testdata
+rm -fr output/*
+ds=(output/defaults output/always-qualify output/known-type output/exact-objects output/readonly output/file-defaults-all-fields output/int64-string output/bytes-base64 output/optional-simples output/embed-enums output/codecs output/codecs-enum-zeros output/outpattern)
+
+# GOPATH src root relative to the testdata directory
+GOPATH_ROOT="../../../../../"
+
+mkdir -p ${ds[*]}
+for e in ./*proto; do
+    # is17.proto needs scalapb.proto, see the Makefile
+    [ "${e}" = ./is17.proto ] && continue
+    protoc -I. -I${GOPATH_ROOT} --flowtypes_out=output/defaults/ "${e}"
+    protoc -I. -I${GOPATH_ROOT} --flowtypes_out=always_qualify_type_names=true:output/always-qualify/ "${e}"
+    # known_type values contain ':', so they are passed with --flowtypes_opt
+    protoc -I. -I${GOPATH_ROOT} --flowtypes_opt=known_type=.google.protobuf.Timestamp:Date --flowtypes_out=output/known-type/ "${e}"
+    protoc -I. -I${GOPATH_ROOT} --flowtypes_out=exact_objects=true:output/exact-objects/ "${e}"
+    protoc -I. -I${GOPATH_ROOT} --flowtypes_out=readonly=true:output/readonly/ "${e}"
+    protoc -I. -I${GOPATH_ROOT} --flowtypes_out=file_defaults_all_fields=true:output/file-defaults-all-fields/ "${e}"
+    protoc -I. -I${GOPATH_ROOT} --flowtypes_out=int64_string=true:output/int64-string/ "${e}"
+    protoc -I. -I${GOPATH_ROOT} --flowtypes_out=bytes_base64=true:output/bytes-base64/ "${e}"
+    protoc -I. -I${GOPATH_ROOT} --flowtypes_out=optional_simples=true:output/optional-simples/ "${e}"
+    protoc -I. -I${GOPATH_ROOT} --flowtypes_out=embed_enums=true:output/embed-enums/ "${e}"
+    protoc -I. -I${GOPATH_ROOT} --flowtypes_out=codecs=true:output/codecs/ "${e}"
+    protoc -I. -I${GOPATH_ROOT} --flowtypes_out=codecs=true,enum_zeros=true:output/codecs-enum-zeros/ "${e}"
+    protoc -I. -I${GOPATH_ROOT} --flowtypes_out 'outpattern={{.Descriptor.GetPackage | replace "." "/"}}/{{.BaseName}}.js:output/outpattern/' "${e}"
+done
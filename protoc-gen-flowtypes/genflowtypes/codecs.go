@@ -0,0 +1,484 @@
+package genflowtypes
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+	"strings"
+	"text/template"
+
+	pbdescriptor "github.com/golang/protobuf/protoc-gen-go/descriptor"
+	"github.com/grpc-ecosystem/grpc-gateway/protoc-gen-grpc-gateway/descriptor"
+)
+
+// codecHelpers are the decoders and combinators used by generated codecs.
+// Their names start with $, which the names of generated decoders never do.
+const codecHelpers = `type $Decoder<T> = (json: mixed, path: string) => T;
+
+function $fail(path: string, expected: string, json: mixed): empty {
+  const actual = json === null ? 'null' : Array.isArray(json) ? 'array' : typeof json;
+  throw new TypeError(` + "`${path}: expected ${expected}, got ${actual}`" + `);
+}
+
+function $decodeObject(json: mixed, path: string): {[key: string]: mixed} {
+  if (json === null || typeof json !== 'object' || Array.isArray(json)) {
+    return $fail(path, 'object', json);
+  }
+  return json;
+}
+
+function $decodeMixed(json: mixed, path: string): any {
+  return json;
+}
+
+function $decodeNull(json: mixed, path: string): null {
+  return null;
+}
+
+function $decodeEmpty(json: mixed, path: string): {} {
+  $decodeObject(json, path);
+  return {};
+}
+
+function $decodeString(json: mixed, path: string): string {
+  return typeof json === 'string' ? json : $fail(path, 'string', json);
+}
+
+function $decodeBoolean(json: mixed, path: string): boolean {
+  return typeof json === 'boolean' ? json : $fail(path, 'boolean', json);
+}
+
+function $decodeNumber(json: mixed, path: string): number {
+  if (typeof json === 'number') {
+    return json;
+  }
+  if (typeof json === 'string' && json.trim() !== '' && (json === 'NaN' || !isNaN(Number(json)))) {
+    return Number(json);
+  }
+  return $fail(path, 'number', json);
+}
+
+function $decodeInt64String(json: mixed, path: string): string {
+  if (typeof json === 'number' && Number.isInteger(json)) {
+    return String(json);
+  }
+  if (typeof json === 'string' && /^-?\d+$/.test(json)) {
+    return json;
+  }
+  return $fail(path, '64 bit integer', json);
+}
+
+function $decodeInt64Number(json: mixed, path: string): number {
+  return Number($decodeInt64String(json, path));
+}
+
+// bytes may be rendered as an opaque type, which can only be created in the
+// file declaring it.
+function $decodeBytes(json: mixed, path: string): any {
+  if (typeof json === 'string' && /^[A-Za-z0-9+/_-]*={0,2}$/.test(json)) {
+    return json;
+  }
+  return $fail(path, 'base64 string', json);
+}
+
+function $decodeTimestamp(json: mixed, path: string): string {
+  if (typeof json === 'string' && /^\d{4}-\d{2}-\d{2}T\d{2}:\d{2}:\d{2}(\.\d{1,9})?(Z|[+-]\d{2}:\d{2})$/.test(json)) {
+    return json;
+  }
+  return $fail(path, 'RFC 3339 timestamp', json);
+}
+
+function $decodeDuration(json: mixed, path: string): string {
+  if (typeof json === 'string' && /^-?\d+(\.\d{1,9})?s$/.test(json)) {
+    return json;
+  }
+  return $fail(path, 'duration', json);
+}
+
+function $decodeAny(json: mixed, path: string): {'@type': string, [key: string]: mixed} {
+  const obj = $decodeObject(json, path);
+  return {...obj, '@type': $decodeString(obj['@type'], ` + "`${path}['@type']`" + `)};
+}
+
+function $decodeEnum(json: mixed, path: string, names: {[number: string]: string}): any {
+  if (typeof json === 'string' && Object.keys(names).some(n => names[n] === json)) {
+    return json;
+  }
+  if (typeof json === 'number' && names[String(json)] !== undefined) {
+    return names[String(json)];
+  }
+  return $fail(path, Object.keys(names).map(n => names[n]).join(' | '), json);
+}
+
+function $array<T>(decode: $Decoder<T>): $Decoder<Array<T>> {
+  return (json, path) => {
+    if (!Array.isArray(json)) {
+      return $fail(path, 'array', json);
+    }
+    return json.map((value, i) => decode(value, ` + "`${path}[${i}]`" + `));
+  };
+}
+
+function $map<T>(decode: $Decoder<T>): $Decoder<{[key: string]: T}> {
+  return (json, path) => {
+    const obj = $decodeObject(json, path);
+    const result = {};
+    Object.keys(obj).forEach(key => {
+      result[key] = decode(obj[key], ` + "`${path}[${JSON.stringify(key)}]`" + `);
+    });
+    return result;
+  };
+}
+
+// missing values of fields that are not optional decode to the proto3 default
+function $withDefault<T>(decode: $Decoder<T>, defaultValue: T): $Decoder<T> {
+  return (json, path) => (json == null ? defaultValue : decode(json, path));
+}
+
+function $required<T>(decode: $Decoder<T>): $Decoder<T> {
+  return (json, path) => (json == null ? $fail(path, 'value', json) : decode(json, path));
+}
+
+function $nullable<T>(decode: $Decoder<T>): $Decoder<?T> {
+  return (json, path) => (json == null ? null : decode(json, path));
+}
+
+function $optional<T>(decode: $Decoder<T>): $Decoder<T | void> {
+  return (json, path) => (json == null ? undefined : decode(json, path));
+}
+
+// enum zero values omitted from types decode to undefined
+function $omitZero<T>(decode: $Decoder<T>, zero: string): $Decoder<T | void> {
+  return (json, path) => (json === zero || json === 0 ? undefined : decode(json, path));
+}
+
+// fields are read by their original name or their JSON name
+function $field<T>(obj: {[key: string]: mixed}, name: string, jsonName: string, path: string, decode: $Decoder<T>): T {
+  const json = obj[name] !== undefined ? obj[name] : obj[jsonName];
+  return decode(json, ` + "`${path}.${name}`" + `);
+}
+
+function $oneof(obj: {[key: string]: mixed}, path: string, name: string, members: Array<[string, string, $Decoder<mixed>]>): any {
+  const result = {};
+  members.forEach(([member, jsonName, decode]) => {
+    const json = obj[member] !== undefined ? obj[member] : obj[jsonName];
+    if (json == null) {
+      return;
+    }
+    if (Object.keys(result).length > 0) {
+      throw new TypeError(` + "`${path}: more than one member of oneof ${name} set`" + `);
+    }
+    result[member] = decode(json, ` + "`${path}.${member}`" + `);
+  });
+  return result;
+}
+`
+
+// knownDecoders maps well-known types to the decoders of their JSON
+// mapping.
+var knownDecoders = map[string]string{
+	".google.protobuf.Timestamp":   "$decodeTimestamp",
+	".google.protobuf.Duration":    "$decodeDuration",
+	".google.protobuf.FieldMask":   "$decodeString",
+	".google.protobuf.Struct":      "$decodeObject",
+	".google.protobuf.Value":       "$decodeMixed",
+	".google.protobuf.ListValue":   "$array($decodeMixed)",
+	".google.protobuf.NullValue":   "$decodeNull",
+	".google.protobuf.Any":         "$decodeAny",
+	".google.protobuf.Empty":       "$decodeEmpty",
+	".google.protobuf.DoubleValue": "$decodeNumber",
+	".google.protobuf.FloatValue":  "$decodeNumber",
+	".google.protobuf.Int32Value":  "$decodeNumber",
+	".google.protobuf.UInt32Value": "$decodeNumber",
+	".google.protobuf.BoolValue":   "$decodeBoolean",
+	".google.protobuf.StringValue": "$decodeString",
+	".google.protobuf.BytesValue":  "$decodeBytes",
+}
+
+// codecDecoder is a generated decode function.
+type codecDecoder struct {
+	Name string
+	Type string
+	Body string
+}
+
+// codecName returns the name of the codec file generated for the proto file
+// name.
+func (cfg GeneratorOptions) codecName(name string) string {
+	return strings.TrimSuffix(cfg.outputName(name), ".js") + ".codec.js"
+}
+
+func decoderName(typeName string) string {
+	return "decode" + typeName
+}
+
+// knownDecoder returns the decoder of a type in KnownTypes or knownDecoders.
+// Types mapped by KnownTypes are not validated.
+func (cfg GeneratorOptions) knownDecoder(name string) (string, bool) {
+	if _, ok := cfg.KnownTypes[name]; ok {
+		return "$decodeMixed", true
+	}
+	switch name {
+	case ".google.protobuf.Int64Value", ".google.protobuf.UInt64Value":
+		return cfg.int64Decoder(), true
+	}
+	d, ok := knownDecoders[name]
+	return d, ok
+}
+
+func (cfg GeneratorOptions) int64Decoder() string {
+	if cfg.Int64AsString {
+		return "$decodeInt64String"
+	}
+	return "$decodeInt64Number"
+}
+
+// fieldDecoder returns the decoder of the values of f and the default value
+// of missing values, if any. Decoders of other files are added to deps.
+func (cfg GeneratorOptions) fieldDecoder(file string, f *descriptor.Field, reg *descriptor.Registry, deps Dependencies) (string, string, error) {
+	decoder, defaultValue := "$decodeMixed", ""
+	switch f.GetType() {
+	case pbdescriptor.FieldDescriptorProto_TYPE_DOUBLE,
+		pbdescriptor.FieldDescriptorProto_TYPE_FLOAT,
+		pbdescriptor.FieldDescriptorProto_TYPE_INT32,
+		pbdescriptor.FieldDescriptorProto_TYPE_FIXED32,
+		pbdescriptor.FieldDescriptorProto_TYPE_UINT32,
+		pbdescriptor.FieldDescriptorProto_TYPE_SFIXED32,
+		pbdescriptor.FieldDescriptorProto_TYPE_SINT32:
+		decoder, defaultValue = "$decodeNumber", "0"
+	case pbdescriptor.FieldDescriptorProto_TYPE_INT64,
+		pbdescriptor.FieldDescriptorProto_TYPE_UINT64,
+		pbdescriptor.FieldDescriptorProto_TYPE_FIXED64,
+		pbdescriptor.FieldDescriptorProto_TYPE_SFIXED64,
+		pbdescriptor.FieldDescriptorProto_TYPE_SINT64:
+		decoder, defaultValue = cfg.int64Decoder(), "0"
+		if cfg.Int64AsString {
+			defaultValue = "'0'"
+		}
+	case pbdescriptor.FieldDescriptorProto_TYPE_BOOL:
+		decoder, defaultValue = "$decodeBoolean", "false"
+	case pbdescriptor.FieldDescriptorProto_TYPE_STRING:
+		decoder, defaultValue = "$decodeString", "''"
+	case pbdescriptor.FieldDescriptorProto_TYPE_BYTES:
+		decoder, defaultValue = "$decodeBytes", "''"
+		if cfg.BytesAsBase64String {
+			defaultValue = "('': any)"
+		}
+	case pbdescriptor.FieldDescriptorProto_TYPE_MESSAGE:
+		ft, err := reg.LookupMsg("", f.GetTypeName())
+		if err != nil {
+			return "", "", err
+		}
+		if ft.GetOptions().GetMapEntry() {
+			value, _, err := cfg.fieldDecoder(file, ft.Fields[1], reg, deps)
+			if err != nil {
+				return "", "", err
+			}
+			return fmt.Sprintf("$map(%s)", value), "{}", nil
+		}
+		if d, ok := cfg.knownDecoder(ft.FQMN()); ok {
+			decoder = d
+		} else {
+			decoder = decoderName(cfg.messageTypeName(ft))
+			if ft.File.GetName() != file {
+				deps.add(ft.File.GetName(), decoder)
+			}
+		}
+	case pbdescriptor.FieldDescriptorProto_TYPE_ENUM:
+		e, err := reg.LookupEnum("", f.GetTypeName())
+		if err != nil {
+			return "", "", err
+		}
+		if d, ok := cfg.knownDecoder(e.FQEN()); ok {
+			decoder = d
+			break
+		}
+		decoder = decoderName(cfg.enumTypeName(e))
+		if e.File.GetName() != file {
+			deps.add(e.File.GetName(), decoder)
+		}
+		if v := e.GetValue(); len(v) > 0 && (cfg.EmitEnumZeros || v[0].GetNumber() != 0) {
+			defaultValue = fmt.Sprintf("'%s'", v[0].GetName())
+		}
+	}
+	if f.GetLabel() == pbdescriptor.FieldDescriptorProto_LABEL_REPEATED {
+		return fmt.Sprintf("$array(%s)", decoder), "[]", nil
+	}
+	return decoder, defaultValue, nil
+}
+
+// messageDecoder generates the decoder of m, following the optionality of
+// the fields of its flowtype t.
+func (cfg GeneratorOptions) messageDecoder(m *descriptor.Message, t *objectFlowType, reg *descriptor.Registry, deps Dependencies) (*codecDecoder, error) {
+	fields := []string{}
+	oneofs := make([][]string, len(m.GetOneofDecl()))
+	i := 0
+	for _, f := range m.Fields {
+		decoder, defaultValue, err := cfg.fieldDecoder(m.File.GetName(), f, reg, deps)
+		if err != nil {
+			return nil, err
+		}
+		if f.OneofIndex != nil {
+			j := f.GetOneofIndex()
+			oneofs[j] = append(oneofs[j], fmt.Sprintf("['%s', '%s', %s]", f.GetName(), f.GetJsonName(), decoder))
+			continue
+		}
+		ft := t.Fields[i]
+		i++
+		if zero := cfg.omittedEnumZero(f, reg); zero != "" && (ft.IsNullable() || !ft.IsRequired()) {
+			decoder = fmt.Sprintf("$omitZero(%s, '%s')", decoder, zero)
+		}
+		switch {
+		case ft.IsNullable():
+			decoder = fmt.Sprintf("$nullable(%s)", decoder)
+		case !ft.IsRequired():
+			decoder = fmt.Sprintf("$optional(%s)", decoder)
+		case defaultValue != "":
+			decoder = fmt.Sprintf("$withDefault(%s, %s)", decoder, defaultValue)
+		default:
+			decoder = fmt.Sprintf("$required(%s)", decoder)
+		}
+		fields = append(fields, fmt.Sprintf("    %s: $field(obj, '%s', '%s', path, %s)", f.GetName(), f.GetName(), f.GetJsonName(), decoder))
+	}
+	for j, members := range oneofs {
+		fields = append(fields, fmt.Sprintf("    ...$oneof(obj, path, '%s', [%s])", m.GetOneofDecl()[j].GetName(), strings.Join(members, ", ")))
+	}
+	body := "  $decodeObject(json, path);\n  return {};"
+	if len(fields) > 0 {
+		body = fmt.Sprintf("  const obj = $decodeObject(json, path);\n  return {\n%s\n  };", strings.Join(fields, ",\n"))
+	}
+	name := cfg.messageTypeName(m)
+	return &codecDecoder{Name: decoderName(name), Type: name, Body: body}, nil
+}
+
+// omittedEnumZero returns the name of the zero value of the enum of f if it
+// is omitted from the enum type, or "" if f is not such an enum field.
+func (cfg GeneratorOptions) omittedEnumZero(f *descriptor.Field, reg *descriptor.Registry) string {
+	if cfg.EmitEnumZeros || f.GetType() != pbdescriptor.FieldDescriptorProto_TYPE_ENUM || f.GetLabel() == pbdescriptor.FieldDescriptorProto_LABEL_REPEATED {
+		return ""
+	}
+	e, err := reg.LookupEnum("", f.GetTypeName())
+	if err != nil {
+		return ""
+	}
+	if _, ok := cfg.knownType(e.FQEN()); ok {
+		return ""
+	}
+	for _, v := range e.GetValue() {
+		if v.GetNumber() == 0 {
+			return v.GetName()
+		}
+	}
+	return ""
+}
+
+// enumDecoder generates the decoder of e, which accepts the values of its
+// flowtype.
+func (cfg GeneratorOptions) enumDecoder(e *descriptor.Enum) *codecDecoder {
+	names := []string{}
+	for _, v := range e.GetValue() {
+		if !cfg.EmitEnumZeros && v.GetNumber() == 0 {
+			continue
+		}
+		names = append(names, fmt.Sprintf("'%d': '%s'", v.GetNumber(), v.GetName()))
+	}
+	name := cfg.enumTypeName(e)
+	return &codecDecoder{
+		Name: decoderName(name),
+		Type: name,
+		Body: fmt.Sprintf("  return $decodeEnum(json, path, {%s});", strings.Join(names, ", ")),
+	}
+}
+
+// generateCodecs generates decoders for the enums and messages of file,
+// which are rendered as result by generateFlowTypes.
+func generateCodecs(file *descriptor.File, registry *descriptor.Registry, options GeneratorOptions) (string, error) {
+	f, err := registry.LookupFile(file.GetName())
+	if err != nil {
+		return "", err
+	}
+	deps := Dependencies{}
+	decoders := []*codecDecoder{}
+	for _, e := range f.Enums {
+		if _, ok := options.knownType(e.FQEN()); ok {
+			continue
+		}
+		decoders = append(decoders, options.enumDecoder(e))
+	}
+	for _, m := range f.Messages {
+		if m.GetOptions().GetMapEntry() {
+			continue
+		}
+		if _, ok := options.knownType(m.FQMN()); ok {
+			continue
+		}
+		t, _, err := options.messageToFlowType(m, registry)
+		if err != nil {
+			return "", err
+		}
+		d, err := options.messageDecoder(m, t.(*namedType).FlowTyper.(*objectFlowType), registry, deps)
+		if err != nil {
+			return "", err
+		}
+		decoders = append(decoders, d)
+	}
+	sort.Slice(decoders, func(i, j int) bool { return decoders[i].Name < decoders[j].Name })
+
+	types := map[string]bool{}
+	for _, d := range decoders {
+		types[d.Type] = true
+	}
+	name := options.codecName(file.GetName())
+	imports := Dependencies{}
+	for dep, decoders := range deps {
+		for d := range decoders {
+			imports.add(relativeImport(name, options.codecName(dep)), d)
+		}
+	}
+
+	buf := new(bytes.Buffer)
+	tmpl, err := template.New("").Parse(`/* @flow */
+/* eslint-disable */
+// Code generated by protoc-gen-flowtypes DO NOT EDIT.
+// InputID: {{.InputID}}
+{{ if .Types -}}
+import type {
+{{range $type, $true := .Types}}  {{$type}},
+{{end -}}
+} from '{{ .TypesPath }}';
+{{ end -}}
+{{ range $path, $decoders := .Dependencies -}}
+import {
+{{range $decoder, $true := $decoders}}  {{$decoder}},
+{{end -}}
+} from '{{ $path }}';
+{{ end }}
+{{.Helpers}}
+{{range .Decoders}}
+export function {{.Name}}(json: mixed, path: string = '$'): {{.Type}} {
+{{.Body}}
+}
+{{end}}`)
+	if err != nil {
+		return "", err
+	}
+	err = tmpl.Execute(buf, struct {
+		GeneratorOptions
+		Types        map[string]bool
+		TypesPath    string
+		Dependencies Dependencies
+		Helpers      string
+		Decoders     []*codecDecoder
+	}{
+		GeneratorOptions: options,
+		Types:            types,
+		TypesPath:        relativeImport(name, options.outputName(file.GetName())),
+		Dependencies:     imports,
+		Helpers:          codecHelpers,
+		Decoders:         decoders,
+	})
+	if err != nil {
+		return "", err
+	}
+	return buf.String(), nil
+}
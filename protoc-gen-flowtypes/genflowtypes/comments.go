@@ -0,0 +1,127 @@
+package genflowtypes
+
+import (
+	"fmt"
+	"strings"
+
+	pbdescriptor "github.com/golang/protobuf/protoc-gen-go/descriptor"
+)
+
+// Field numbers of FileDescriptorProto, DescriptorProto and
+// ServiceDescriptorProto used in SourceCodeInfo location paths.
+const (
+	fileMessagePath   = 4
+	fileEnumPath      = 5
+	fileServicePath   = 6
+	messageFieldPath  = 2
+	messageNestedPath = 3
+	messageEnumPath   = 4
+	serviceMethodPath = 2
+)
+
+// docComment is the documentation of a declaration.
+type docComment struct {
+	location   *pbdescriptor.SourceCodeInfo_Location
+	deprecated bool
+}
+
+// comments maps the fully qualified names of messages, enums, services and
+// of fields and methods (as <parent>.<name>) to their documentation.
+type comments map[string]docComment
+
+// fileComments collects the comments of the declarations in f.
+func fileComments(f *pbdescriptor.FileDescriptorProto) comments {
+	locations := map[string]*pbdescriptor.SourceCodeInfo_Location{}
+	for _, loc := range f.GetSourceCodeInfo().GetLocation() {
+		locations[pathKey(loc.GetPath())] = loc
+	}
+	c := comments{}
+	add := func(name string, path []int32, deprecated bool) {
+		c[name] = docComment{location: locations[pathKey(path)], deprecated: deprecated}
+	}
+	prefix := ""
+	if f.GetPackage() != "" {
+		prefix = "." + f.GetPackage()
+	}
+
+	var addEnum func(e *pbdescriptor.EnumDescriptorProto, scope string, path []int32)
+	addEnum = func(e *pbdescriptor.EnumDescriptorProto, scope string, path []int32) {
+		add(scope+"."+e.GetName(), path, e.GetOptions().GetDeprecated())
+	}
+	var addMessage func(m *pbdescriptor.DescriptorProto, scope string, path []int32)
+	addMessage = func(m *pbdescriptor.DescriptorProto, scope string, path []int32) {
+		name := scope + "." + m.GetName()
+		add(name, path, m.GetOptions().GetDeprecated())
+		for i, field := range m.GetField() {
+			add(name+"."+field.GetName(), appendPath(path, messageFieldPath, i), field.GetOptions().GetDeprecated())
+		}
+		for i, nested := range m.GetNestedType() {
+			addMessage(nested, name, appendPath(path, messageNestedPath, i))
+		}
+		for i, e := range m.GetEnumType() {
+			addEnum(e, name, appendPath(path, messageEnumPath, i))
+		}
+	}
+	for i, m := range f.GetMessageType() {
+		addMessage(m, prefix, []int32{fileMessagePath, int32(i)})
+	}
+	for i, e := range f.GetEnumType() {
+		addEnum(e, prefix, []int32{fileEnumPath, int32(i)})
+	}
+	for i, s := range f.GetService() {
+		name := prefix + "." + s.GetName()
+		path := []int32{fileServicePath, int32(i)}
+		add(name, path, s.GetOptions().GetDeprecated())
+		for j, m := range s.GetMethod() {
+			add(name+"."+m.GetName(), appendPath(path, serviceMethodPath, j), m.GetOptions().GetDeprecated())
+		}
+	}
+	return c
+}
+
+func appendPath(path []int32, field int32, index int) []int32 {
+	p := make([]int32, len(path), len(path)+2)
+	copy(p, path)
+	return append(p, field, int32(index))
+}
+
+func pathKey(path []int32) string {
+	return fmt.Sprint(path)
+}
+
+// doc renders the leading and trailing comments and the deprecation of name
+// as a /** */ block indented by indent, or "" if there are none.
+func (c comments) doc(name, indent string) string {
+	d, ok := c[name]
+	if !ok {
+		return ""
+	}
+	lines := []string{}
+	for _, comment := range []string{d.location.GetLeadingComments(), d.location.GetTrailingComments()} {
+		comment = strings.TrimRight(comment, "\n ")
+		if strings.TrimSpace(comment) == "" {
+			continue
+		}
+		if len(lines) > 0 {
+			lines = append(lines, "")
+		}
+		for _, line := range strings.Split(comment, "\n") {
+			// protoc keeps the space following //
+			line = strings.TrimPrefix(strings.TrimRight(line, " "), " ")
+			lines = append(lines, strings.Replace(line, "*/", "*\\/", -1))
+		}
+	}
+	if d.deprecated {
+		lines = append(lines, "@deprecated")
+	}
+	if len(lines) == 0 {
+		return ""
+	}
+	buf := &strings.Builder{}
+	fmt.Fprintf(buf, "%s/**\n", indent)
+	for _, line := range lines {
+		fmt.Fprintf(buf, "%s%s\n", indent, strings.TrimRight(" * "+line, " "))
+	}
+	fmt.Fprintf(buf, "%s */\n", indent)
+	return buf.String()
+}
@@ -1,10 +1,14 @@
 package genflowtypes
 
 import (
+	"bytes"
 	"fmt"
+	"path"
 	"path/filepath"
 	"strings"
+	"text/template"
 
+	"github.com/Masterminds/sprig"
 	"github.com/pkg/errors"
 
 	"github.com/gabriel/grpcutil/protoc-gen-flowtypes/opts"
@@ -33,34 +37,193 @@ type GeneratorOptions struct {
 	AlwaysQualifyTypes bool
 	EmbedEnums         bool
 	OptonalSimpleTypes bool
-	FilenameOverride   string
-	EmitEnumZeros      bool
-	InputID            string
-	DumpJSON           bool
-	ProtoOptions       opts.Options
+	// OutPattern is a template of output file names, executed with an
+	// OutputNameContext.
+	OutPattern string
+	// FilenameOverride names the output files of the targets in order, as
+	// "+" separated names.
+	//
+	// Deprecated: use OutPattern.
+	FilenameOverride string
+	EmitEnumZeros    bool
+	InputID          string
+	DumpJSON         bool
+	// ExactObjects renders messages as exact object types.
+	ExactObjects bool
+	// ReadOnly renders properties as covariant and repeated fields as
+	// $ReadOnlyArray.
+	ReadOnly bool
+	// KnownTypes maps fully qualified proto type names to flowtypes,
+	// adding to or overriding the well-known types.
+	KnownTypes map[string]string
+	// Int64AsString renders 64 bit integers as strings, as in the proto3
+	// JSON mapping.
+	Int64AsString bool
+	// BytesAsBase64String renders bytes as the opaque Base64String type.
+	BytesAsBase64String bool
+	// Codecs generates a companion <base>.codec.js file with decoders
+	// validating JSON payloads.
+	Codecs bool
+	// FileDefaultsAllFields applies the file level (opts.field_defaults) to
+	// scalar and message fields, which otherwise only take the message
+	// defaults and field options.
+	FileDefaultsAllFields bool
+	ProtoOptions          opts.Options
+
+	// comments holds the documentation of the file being generated.
+	comments comments
+	// outputNames maps the names of target proto files to output names.
+	outputNames map[string]string
+}
+
+// DefaultOutPattern names output files after their proto files.
+const DefaultOutPattern = "{{.Dir}}/{{.BaseName}}.js"
+
+// OutputNameContext is the data available to OutPattern.
+type OutputNameContext struct {
+	Dir        string
+	BaseName   string
+	Descriptor *descriptor.File
+}
+
+func defaultOutputName(name string) string {
+	ext := filepath.Ext(name)
+	base := strings.TrimSuffix(name, ext)
+	return fmt.Sprintf("%s.js", base)
 }
 
-func defaultOutputNames(targets []*descriptor.File) []string {
-	result := []string{}
+// outputNames executes pattern for each of targets, or takes the names of
+// override in order if it is set.
+func outputNames(targets []*descriptor.File, pattern, override string) (map[string]string, error) {
+	names := make(map[string]string)
+	if override != "" {
+		overrides := strings.Split(override, "+")
+		if len(overrides) != len(targets) {
+			return nil, fmt.Errorf("output: %d names given for %d files", len(overrides), len(targets))
+		}
+		for i, file := range targets {
+			names[file.GetName()] = path.Clean(filepath.ToSlash(overrides[i]))
+		}
+		return names, nil
+	}
+	if pattern == "" {
+		pattern = DefaultOutPattern
+	}
+	t, err := template.New("outpattern").Funcs(sprig.TxtFuncMap()).Option("missingkey=error").Parse(pattern)
+	if err != nil {
+		return nil, errors.Wrap(err, "outpattern")
+	}
 	for _, file := range targets {
-		name := file.GetName()
-		ext := filepath.Ext(name)
-		base := strings.TrimSuffix(name, ext)
-		result = append(result, fmt.Sprintf("%s.js", base))
+		base := filepath.Base(file.GetName())
+		ctx := &OutputNameContext{
+			Dir:        filepath.Dir(file.GetName()),
+			BaseName:   strings.TrimSuffix(base, filepath.Ext(base)),
+			Descriptor: file,
+		}
+		buf := new(bytes.Buffer)
+		if err := t.Execute(buf, ctx); err != nil {
+			return nil, errors.Wrapf(err, "outpattern: %s", file.GetName())
+		}
+		name := path.Clean(filepath.ToSlash(strings.TrimSpace(buf.String())))
+		if name == "." || strings.HasSuffix(name, "/") {
+			return nil, fmt.Errorf("outpattern: empty output name for %s", file.GetName())
+		}
+		names[file.GetName()] = name
 	}
-	return result
+	return names, nil
+}
+
+// checkOutputNames checks that the types, codecs and Base64String files
+// generated for targets are given distinct names.
+func (cfg GeneratorOptions) checkOutputNames(targets []*descriptor.File) error {
+	generatedBy := make(map[string]string)
+	add := func(name, by string) error {
+		if other, ok := generatedBy[name]; ok {
+			return fmt.Errorf("%s and %s are both output to %s", other, by, name)
+		}
+		generatedBy[name] = by
+		return nil
+	}
+	if cfg.BytesAsBase64String {
+		if err := add(Base64StringFile, "Base64String"); err != nil {
+			return err
+		}
+	}
+	for _, file := range targets {
+		if err := add(cfg.outputName(file.GetName()), file.GetName()); err != nil {
+			return err
+		}
+		if cfg.Codecs {
+			if err := add(cfg.codecName(file.GetName()), file.GetName()+" codecs"); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// outputName returns the name of the file generated for the proto file name.
+func (cfg GeneratorOptions) outputName(name string) string {
+	if n, ok := cfg.outputNames[name]; ok {
+		return n
+	}
+	return defaultOutputName(name)
+}
+
+// dependencies returns the files imported by targets, directly or not.
+func (g *Generator) dependencies(targets []*descriptor.File) []*descriptor.File {
+	seen := make(map[string]bool)
+	var deps []*descriptor.File
+	var add func(file *descriptor.File)
+	add = func(file *descriptor.File) {
+		for _, name := range file.GetDependency() {
+			if seen[name] {
+				continue
+			}
+			seen[name] = true
+			dep, err := g.reg.LookupFile(name)
+			if err != nil {
+				continue
+			}
+			deps = append(deps, dep)
+			add(dep)
+		}
+	}
+	for _, file := range targets {
+		add(file)
+	}
+	return deps
 }
 
 // Generate processes the given proto files and produces flowtype output.
 func (g *Generator) Generate(targets []*descriptor.File, opts GeneratorOptions) ([]*plugin.CodeGeneratorResponse_File, error) {
 	var files []*plugin.CodeGeneratorResponse_File
-	outputNames := defaultOutputNames(targets)
-	if opts.FilenameOverride != "" {
-		outputNames = strings.Split(opts.FilenameOverride, "+")
+	names, err := outputNames(targets, opts.OutPattern, opts.FilenameOverride)
+	if err != nil {
+		return nil, err
+	}
+	// dependencies are imported from the files the pattern names them
+	depNames, err := outputNames(g.dependencies(targets), opts.OutPattern, "")
+	if err != nil {
+		return nil, err
 	}
-	for i, file := range targets {
+	for file, name := range depNames {
+		if _, ok := names[file]; !ok {
+			names[file] = name
+		}
+	}
+	opts.outputNames = names
+	if err := opts.checkOutputNames(targets); err != nil {
+		return nil, err
+	}
+	base64String := false
+	for _, file := range targets {
 		glog.V(1).Infof("Processing %s", file.GetName())
-		code, err := generateFlowTypes(file, g.reg, opts)
+		fileOpts, err := withFileOptions(file, opts)
+		if err != nil {
+			return nil, err
+		}
+		code, err := generateFlowTypes(file, g.reg, fileOpts)
 		if err == errNoTargetService {
 			glog.V(1).Infof("%s: %v", file.GetName(), err)
 			continue
@@ -70,10 +233,28 @@ func (g *Generator) Generate(targets []*descriptor.File, opts GeneratorOptions)
 		}
 
 		files = append(files, &plugin.CodeGeneratorResponse_File{
-			Name:    proto.String(outputNames[i]),
+			Name:    proto.String(opts.outputName(file.GetName())),
 			Content: proto.String(code),
 		})
-		glog.V(1).Infof("Will emit %s", outputNames[i])
+		glog.V(1).Infof("Will emit %s", opts.outputName(file.GetName()))
+		base64String = base64String || opts.usesBase64String(file)
+
+		if opts.Codecs {
+			codec, err := generateCodecs(file, g.reg, fileOpts)
+			if err != nil {
+				return nil, errors.Wrap(err, "generateCodecs")
+			}
+			files = append(files, &plugin.CodeGeneratorResponse_File{
+				Name:    proto.String(opts.codecName(file.GetName())),
+				Content: proto.String(codec),
+			})
+		}
+	}
+	if base64String {
+		files = append(files, &plugin.CodeGeneratorResponse_File{
+			Name:    proto.String(Base64StringFile),
+			Content: proto.String(base64StringModule),
+		})
 	}
 	return files, nil
 }
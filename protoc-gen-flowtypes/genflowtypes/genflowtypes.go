@@ -4,9 +4,12 @@ import (
 	"bytes"
 	"fmt"
 	"os"
+	"path"
+	"path/filepath"
 	"strings"
 	"text/template"
 	"time"
+	"unicode"
 
 	"github.com/pkg/errors"
 
@@ -17,9 +20,17 @@ import (
 	"github.com/grpc-ecosystem/grpc-gateway/protoc-gen-grpc-gateway/descriptor"
 )
 
-// Dependencies maps packages names to imported type names.
+// Dependencies maps the names of the proto files defining imported types (or,
+// once resolved, import paths) to imported type names.
 type Dependencies map[string]map[string]bool
 
+func (d Dependencies) add(file, typeName string) {
+	if _, ok := d[file]; !ok {
+		d[file] = make(map[string]bool)
+	}
+	d[file][typeName] = true
+}
+
 // FlowTyper is a flow language type
 type FlowTyper interface {
 	FlowType() string
@@ -27,16 +38,116 @@ type FlowTyper interface {
 	IsNullable() bool
 }
 
-// NamedFlowTyper is a FlowTyper with a name.
+// NamedFlowTyper is a FlowTyper with a name and documentation.
 type NamedFlowTyper interface {
 	FlowTyper
 	Name() string
+	Comment() string
 }
 
 // knownTypeMap is a map of paths for known proto types to their desired
-// flowtypes.
+// flowtypes, following the proto3 JSON mapping. The 64 bit integer and bytes
+// wrappers are rendered by knownType like the fields they wrap.
 var knownTypeMap = map[string]string{
-	".google.protobuf.Timestamp": "string",
+	".google.protobuf.Timestamp":   "string",
+	".google.protobuf.Duration":    "string",
+	".google.protobuf.FieldMask":   "string",
+	".google.protobuf.Struct":      "{[key: string]: mixed}",
+	".google.protobuf.Value":       "mixed",
+	".google.protobuf.ListValue":   "Array<mixed>",
+	".google.protobuf.NullValue":   "null",
+	".google.protobuf.Any":         "{'@type': string, [key: string]: mixed}",
+	".google.protobuf.Empty":       "{}",
+	".google.protobuf.DoubleValue": "number",
+	".google.protobuf.FloatValue":  "number",
+	".google.protobuf.Int32Value":  "number",
+	".google.protobuf.UInt32Value": "number",
+	".google.protobuf.BoolValue":   "boolean",
+	".google.protobuf.StringValue": "string",
+}
+
+// base64String is the opaque type of bytes values when BytesAsBase64String
+// is set, declared once in Base64StringFile.
+const base64String = "Base64String"
+
+// Base64StringFile is the name of the output file declaring Base64String.
+const Base64StringFile = "base64string.js"
+
+// base64StringModule declares Base64String with functions converting to and
+// from strings, which are the only way to create or unwrap its values.
+const base64StringModule = `/* @flow */
+/* eslint-disable */
+// Code generated by protoc-gen-flowtypes DO NOT EDIT.
+
+export opaque type Base64String: string = string;
+
+// toBase64String checks that value is encoded in the standard or URL safe
+// base64 alphabet, as accepted by the proto3 JSON mapping.
+export function toBase64String(value: string): Base64String {
+  if (!/^[A-Za-z0-9+/_-]*={0,2}$/.test(value)) {
+    throw new TypeError(` + "`expected base64 string, got ${JSON.stringify(value)}`" + `);
+  }
+  return value;
+}
+
+export function fromBase64String(value: Base64String): string {
+  return value;
+}
+`
+
+// knownType returns the flowtype for the fully qualified proto type name,
+// preferring entries from KnownTypes over knownTypeMap.
+func (cfg GeneratorOptions) knownType(name string) (string, bool) {
+	if t, ok := cfg.KnownTypes[name]; ok {
+		return t, true
+	}
+	switch name {
+	case ".google.protobuf.Int64Value", ".google.protobuf.UInt64Value":
+		// consistent with int64 fields, which are strings in the proto3
+		// JSON mapping only with int64_string
+		return cfg.int64Type(), true
+	case ".google.protobuf.BytesValue":
+		return cfg.bytesType(), true
+	}
+	t, ok := knownTypeMap[name]
+	return t, ok
+}
+
+// int64Type returns the flowtype of 64 bit integers.
+func (cfg GeneratorOptions) int64Type() string {
+	if cfg.Int64AsString {
+		return "string"
+	}
+	return "number"
+}
+
+// bytesType returns the flowtype of bytes.
+func (cfg GeneratorOptions) bytesType() string {
+	if cfg.BytesAsBase64String {
+		return base64String
+	}
+	return "string"
+}
+
+// usesBase64String reports whether any field of f is rendered as
+// base64String.
+func (cfg GeneratorOptions) usesBase64String(f *descriptor.File) bool {
+	if !cfg.BytesAsBase64String {
+		return false
+	}
+	for _, m := range f.Messages {
+		for _, field := range m.Fields {
+			switch field.GetType() {
+			case pbdescriptor.FieldDescriptorProto_TYPE_BYTES:
+				return true
+			case pbdescriptor.FieldDescriptorProto_TYPE_MESSAGE:
+				if t, ok := cfg.knownType(field.GetTypeName()); ok && t == base64String {
+					return true
+				}
+			}
+		}
+	}
+	return false
 }
 
 func newSimpleType(typeString string, opts opts.Options) *primitiveType {
@@ -63,63 +174,129 @@ func newMessageFlowType(typeName string, opts opts.Options) *messageType {
 
 type repeatedType struct {
 	FlowTyper
-	opts opts.Options
+	opts     opts.Options
+	readOnly bool
+}
+
+func newRepeatedFlowType(underlying FlowTyper, opts opts.Options, readOnly bool) *repeatedType {
+	return &repeatedType{FlowTyper: underlying, opts: opts, readOnly: readOnly}
+}
+
+func (r repeatedType) FlowType() string {
+	if r.readOnly {
+		return fmt.Sprintf("$ReadOnlyArray<%s>", r.FlowTyper.FlowType())
+	}
+	return fmt.Sprintf("Array<%s>", r.FlowTyper.FlowType())
 }
 
-func newRepeatedFlowType(underlying FlowTyper, opts opts.Options) *repeatedType {
-	return &repeatedType{FlowTyper: underlying, opts: opts}
+// mapType is a map field, which the JSON encoding renders as an object keyed
+// by the string form of the map keys.
+type mapType struct {
+	value    FlowTyper
+	opts     opts.Options
+	readOnly bool
 }
 
-func (r repeatedType) FlowType() string { return fmt.Sprintf("Array<%s>", r.FlowTyper.FlowType()) }
+func newMapFlowType(value FlowTyper, opts opts.Options, readOnly bool) *mapType {
+	return &mapType{value: value, opts: opts, readOnly: readOnly}
+}
+
+func (m mapType) FlowType() string {
+	variance := ""
+	if m.readOnly {
+		variance = "+"
+	}
+	return fmt.Sprintf("{%s[key: string]: %s}", variance, m.value.FlowType())
+}
+func (m mapType) IsRequired() bool { return m.opts.GetRequired() }
+func (m mapType) IsNullable() bool { return m.opts.GetNullable() }
 
 type namedType struct {
 	FlowTyper
-	name string
-	opts opts.Options
+	name    string
+	opts    opts.Options
+	comment string
 }
 
 func (t *namedType) Name() string {
 	return t.name
 }
 
+// Comment returns the doc comment rendered before the declaration.
+func (t *namedType) Comment() string {
+	return t.comment
+}
+
 type objectFlowType struct {
-	Fields  []NamedFlowTyper
+	Fields []NamedFlowTyper
+	// Oneofs holds the members of each oneof, which are rendered as
+	// disjoint unions of exact objects spread into the object.
+	Oneofs  [][]NamedFlowTyper
 	Options GeneratorOptions
 
 	opts opts.Options
 }
 
+func (t *objectFlowType) fieldFlowType(f NamedFlowTyper, required bool) string {
+	variance := ""
+	optionalIndicator := "?"
+	nullableIndicator := "?"
+	if t.Options.ReadOnly {
+		variance = "+"
+	}
+	if required {
+		optionalIndicator = ""
+	}
+	if !f.IsNullable() {
+		nullableIndicator = ""
+	}
+	return fmt.Sprintf("%s%s%s: %s%s", variance, f.Name(), optionalIndicator, nullableIndicator, f.FlowType())
+}
+
 func (t *objectFlowType) FlowType() string {
 	fields := []string{}
 	for _, f := range t.Fields {
-		optionalIndicator := "?"
-		nullableIndicator := "?"
-		if f.IsRequired() {
-			optionalIndicator = ""
-		}
-		if !f.IsNullable() {
-			nullableIndicator = ""
-		}
-		field := fmt.Sprintf("  %s%s: %s%s", f.Name(), optionalIndicator, nullableIndicator, f.FlowType())
+		field := fmt.Sprintf("%s  %s", f.Comment(), t.fieldFlowType(f, f.IsRequired()))
 		// glog.V(1).Infof("Field: %s", field)
 		fields = append(fields, field)
 	}
+	for _, oneof := range t.Oneofs {
+		members := []string{}
+		for _, f := range oneof {
+			members = append(members, fmt.Sprintf("{| %s |}", t.fieldFlowType(f, true)))
+		}
+		// none of the members set
+		members = append(members, "{||}")
+		fields = append(fields, fmt.Sprintf("  ...(%s)", strings.Join(members, " | ")))
+	}
+	if t.Options.ExactObjects {
+		return fmt.Sprintf("{|\n%s\n|}", strings.Join(fields, ",\n"))
+	}
 	return fmt.Sprintf("{\n%s\n}", strings.Join(fields, ",\n"))
 }
 
 func (t *objectFlowType) IsRequired() bool { return t.opts.GetRequired() }
 func (t *objectFlowType) IsNullable() bool { return t.opts.GetNullable() }
 
-func (cfg GeneratorOptions) fieldToType(pkg string, f *descriptor.Field, reg *descriptor.Registry, defOpts opts.Options) (NamedFlowTyper, Dependencies, error) {
+// fieldToType returns the type of f. defOpts are the file defaults and
+// fieldOpts the message defaults, label and field options layered over them.
+// Scalar and message fields only take the file defaults with
+// FileDefaultsAllFields.
+func (cfg GeneratorOptions) fieldToType(file string, f *descriptor.Field, reg *descriptor.Registry, defOpts, fieldOpts opts.Options) (NamedFlowTyper, Dependencies, error) {
+	simpleOpts := fieldOpts
+	if cfg.FileDefaultsAllFields {
+		simpleOpts = mergeOptions(defOpts, fieldOpts)
+	}
+	defOpts = mergeOptions(defOpts, fieldOpts)
 
-	primitiveOpts := opts.Options{
-		Required: &[]bool{true}[0],
+	primitiveOpts := mergeOptions(opts.Options{
+		Required: &[]bool{!cfg.OptonalSimpleTypes}[0],
 		Nullable: &[]bool{false}[0],
-	}
-	messageOpts := opts.Options{
+	}, simpleOpts)
+	messageOpts := mergeOptions(opts.Options{
 		Required: &[]bool{true}[0],
 		Nullable: &[]bool{true}[0],
-	}
+	}, simpleOpts)
 
 	// FieldMessage
 	var fieldType FlowTyper = newSimpleType("any", defOpts)
@@ -130,26 +307,26 @@ func (cfg GeneratorOptions) fieldToType(pkg string, f *descriptor.Field, reg *de
 		fallthrough
 	case pbdescriptor.FieldDescriptorProto_TYPE_FLOAT:
 		fallthrough
-	case pbdescriptor.FieldDescriptorProto_TYPE_INT64:
-		fallthrough
-	case pbdescriptor.FieldDescriptorProto_TYPE_UINT64:
-		fallthrough
 	case pbdescriptor.FieldDescriptorProto_TYPE_INT32:
 		fallthrough
-	case pbdescriptor.FieldDescriptorProto_TYPE_FIXED64:
-		fallthrough
 	case pbdescriptor.FieldDescriptorProto_TYPE_FIXED32:
 		fallthrough
 	case pbdescriptor.FieldDescriptorProto_TYPE_UINT32:
 		fallthrough
 	case pbdescriptor.FieldDescriptorProto_TYPE_SFIXED32:
 		fallthrough
-	case pbdescriptor.FieldDescriptorProto_TYPE_SFIXED64:
-		fallthrough
 	case pbdescriptor.FieldDescriptorProto_TYPE_SINT32:
+		fieldType = newSimpleType("number", primitiveOpts)
+	case pbdescriptor.FieldDescriptorProto_TYPE_INT64:
+		fallthrough
+	case pbdescriptor.FieldDescriptorProto_TYPE_UINT64:
+		fallthrough
+	case pbdescriptor.FieldDescriptorProto_TYPE_FIXED64:
+		fallthrough
+	case pbdescriptor.FieldDescriptorProto_TYPE_SFIXED64:
 		fallthrough
 	case pbdescriptor.FieldDescriptorProto_TYPE_SINT64:
-		fieldType = newSimpleType("number", primitiveOpts)
+		fieldType = newSimpleType(cfg.int64Type(), primitiveOpts)
 	case pbdescriptor.FieldDescriptorProto_TYPE_BOOL:
 		fieldType = newSimpleType("boolean", primitiveOpts)
 	case pbdescriptor.FieldDescriptorProto_TYPE_STRING:
@@ -162,42 +339,87 @@ func (cfg GeneratorOptions) fieldToType(pkg string, f *descriptor.Field, reg *de
 		if err != nil {
 			return nil, nil, err
 		}
-		if flowType, present := knownTypeMap[ft.FQMN()]; present {
+		if ft.GetOptions().GetMapEntry() {
+			value, valueDeps, err := cfg.fieldToType(file, ft.Fields[1], reg, defOpts, fieldOpts)
+			if err != nil {
+				return nil, nil, err
+			}
+			mapType := newMapFlowType(value, primitiveOpts, cfg.ReadOnly)
+			return &namedType{FlowTyper: mapType, name: f.GetName(), opts: defOpts, comment: cfg.fieldComment(f)}, valueDeps, nil
+		}
+		if flowType, present := cfg.knownType(ft.FQMN()); present {
 			fieldType = newSimpleType(flowType, messageOpts)
 		} else {
 			fieldType = newMessageFlowType(cfg.messageTypeName(ft), messageOpts)
-			if ft.File.GetPackage() != pkg {
-				parts := strings.Split(ft.FQMN(), ".")
-				pn := strings.Join(parts[:len(parts)-1], "")
-				if _, ok := deps[pn]; !ok {
-					deps[pn] = make(map[string]bool)
-				}
-				deps[pn][ft.GetName()] = true
+			if ft.File.GetName() != file {
+				deps.add(ft.File.GetName(), cfg.messageTypeName(ft))
 			}
-
 		}
 	case pbdescriptor.FieldDescriptorProto_TYPE_BYTES:
-		fieldType = newSimpleType("string", defOpts) // could be more correct
+		// bytes fields keep the optionality of the field defaults unless
+		// rendered as Base64String
+		bytesOpts := defOpts
+		if cfg.BytesAsBase64String {
+			bytesOpts = primitiveOpts
+		}
+		fieldType = newSimpleType(cfg.bytesType(), bytesOpts)
 	case pbdescriptor.FieldDescriptorProto_TYPE_ENUM:
 		e, err := reg.LookupEnum("", f.GetTypeName())
 		if err != nil {
 			return nil, nil, err
 		}
 
-		if cfg.EmbedEnums {
-			fieldType, deps, err = cfg.enumToFlowType(e, reg)
+		if flowType, present := cfg.knownType(e.FQEN()); present {
+			fieldType = newSimpleType(flowType, defOpts)
+		} else if cfg.EmbedEnums {
+			enumType, enumDeps, err := cfg.enumToFlowType(e, reg)
 			if err != nil {
 				return nil, nil, err
 			}
+			fieldType, deps = newSimpleType(enumType.FlowType(), defOpts), enumDeps
 		} else {
 			name := cfg.enumTypeName(e)
 			fieldType = newSimpleType(name, defOpts)
+			if e.File.GetName() != file {
+				deps.add(e.File.GetName(), name)
+			}
 		}
 	}
 	if f.GetLabel() == pbdescriptor.FieldDescriptorProto_LABEL_REPEATED {
-		fieldType = newRepeatedFlowType(fieldType, defOpts)
+		fieldType = newRepeatedFlowType(fieldType, defOpts, cfg.ReadOnly)
+	}
+	return &namedType{FlowTyper: fieldType, name: f.GetName(), opts: defOpts, comment: cfg.fieldComment(f)}, deps, nil
+}
+
+func (cfg GeneratorOptions) fieldComment(f *descriptor.Field) string {
+	if f.Message == nil {
+		return ""
+	}
+	return cfg.comments.doc(fmt.Sprintf("%s.%s", f.Message.FQMN(), f.GetName()), "  ")
+}
+
+// mergeOptions returns dst with the options set in src overriding it.
+func mergeOptions(dst, src opts.Options) opts.Options {
+	if src.Required != nil {
+		dst.Required = src.Required
+	}
+	if src.Nullable != nil {
+		dst.Nullable = src.Nullable
 	}
-	return &namedType{FlowTyper: fieldType, name: f.GetName(), opts: defOpts}, deps, nil
+	return dst
+}
+
+func getMessageOptionsIfAny(message *pbdescriptor.DescriptorProto) opts.Options {
+	if message.Options != nil {
+		v, err := proto.GetExtension(message.Options, opts.E_MessageFieldDefaults)
+		if err != nil {
+			return opts.Options{}
+		}
+		if o := v.(*opts.Options); o != nil {
+			return *o
+		}
+	}
+	return opts.Options{}
 }
 
 func getFieldOptionsIfAny(field *pbdescriptor.FieldDescriptorProto) opts.Options {
@@ -218,45 +440,49 @@ func (cfg GeneratorOptions) messageToFlowType(m *descriptor.Message, reg *descri
 	deps := Dependencies{}
 	t := &objectFlowType{
 		Fields:  []NamedFlowTyper{},
+		Oneofs:  make([][]NamedFlowTyper, len(m.GetOneofDecl())),
 		Options: cfg,
 	}
+	// options are layered as file defaults, message defaults, the proto2
+	// required label and then the field options.
+	messageOpts := getMessageOptionsIfAny(m.DescriptorProto)
 	for _, f := range m.Fields {
-		opts := cfg.ProtoOptions
-		fieldOpts := getFieldOptionsIfAny(f.FieldDescriptorProto)
-		if fieldOpts.Required != nil {
-			opts.Required = fieldOpts.Required
+		fieldOpts := messageOpts
+		if f.GetLabel() == pbdescriptor.FieldDescriptorProto_LABEL_REQUIRED {
+			fieldOpts.Required = &[]bool{true}[0]
 		}
-		if fieldOpts.Nullable != nil {
-			opts.Nullable = fieldOpts.Nullable
-		}
-		field, newDeps, err := cfg.fieldToType(m.File.GetPackage(), f, reg, opts)
+		fieldOpts = mergeOptions(fieldOpts, getFieldOptionsIfAny(f.FieldDescriptorProto))
+		field, newDeps, err := cfg.fieldToType(m.File.GetName(), f, reg, cfg.ProtoOptions, fieldOpts)
 		if err != nil {
 			return nil, nil, err
 		}
 		mergeDeps(deps, newDeps)
+		if f.OneofIndex != nil {
+			i := f.GetOneofIndex()
+			t.Oneofs[i] = append(t.Oneofs[i], field)
+			continue
+		}
 		t.Fields = append(t.Fields, field)
 	}
-	return &namedType{FlowTyper: t, name: cfg.messageTypeName(m)}, deps, nil
+	return &namedType{FlowTyper: t, name: cfg.messageTypeName(m), comment: cfg.comments.doc(m.FQMN(), "")}, deps, nil
 }
 
-func (cfg GeneratorOptions) enumTypeName(e *descriptor.Enum) string {
-	name := strings.Replace(e.FQEN(), ".", "", -1)
-	if !cfg.AlwaysQualifyTypes {
-		if strings.HasPrefix(name, e.File.GoPkg.Name) {
-			name = name[len(e.File.GoPkg.Name):]
-		}
+// typeName joins the names of outer messages and name, prefixed with the
+// package name if types are always qualified.
+func (cfg GeneratorOptions) typeName(pkg string, outers []string, name string) string {
+	name = strings.Join(outers, "") + name
+	if cfg.AlwaysQualifyTypes {
+		name = strings.Replace(pkg, ".", "", -1) + name
 	}
 	return name
 }
 
+func (cfg GeneratorOptions) enumTypeName(e *descriptor.Enum) string {
+	return cfg.typeName(e.File.GetPackage(), e.Outers, e.GetName())
+}
+
 func (cfg GeneratorOptions) messageTypeName(m *descriptor.Message) string {
-	name := strings.Replace(m.FQMN(), ".", "", -1)
-	if !cfg.AlwaysQualifyTypes {
-		if strings.HasPrefix(name, m.File.GoPkg.Name) {
-			name = name[len(m.File.GoPkg.Name):]
-		}
-	}
-	return name
+	return cfg.typeName(m.File.GetPackage(), m.Outers, m.GetName())
 }
 
 func (cfg GeneratorOptions) enumToFlowType(e *descriptor.Enum, reg *descriptor.Registry) (FlowTyper, Dependencies, error) {
@@ -271,9 +497,101 @@ func (cfg GeneratorOptions) enumToFlowType(e *descriptor.Enum, reg *descriptor.R
 	return &namedType{
 		FlowTyper: newSimpleType(strings.Join(options, " | "), cfg.ProtoOptions),
 		name:      name,
+		comment:   cfg.comments.doc(e.FQEN(), ""),
 	}, nil, nil
 }
 
+// serviceFlowType is a service rendered as an interface of client methods.
+type serviceFlowType struct {
+	name    string
+	methods []string
+	comment string
+}
+
+func (s *serviceFlowType) Name() string    { return s.name }
+func (s *serviceFlowType) Comment() string { return s.comment }
+
+func (s *serviceFlowType) FlowType() string {
+	return fmt.Sprintf("{\n%s\n}", strings.Join(s.methods, "\n"))
+}
+
+// streamTypes declares the handles returned by streaming service methods.
+const streamTypes = `export interface ClientReadableStream<Response> {
+  on(event: 'data', handler: (response: Response) => void): ClientReadableStream<Response>;
+  on(event: 'error', handler: (error: Error) => void): ClientReadableStream<Response>;
+  on(event: 'end', handler: () => void): ClientReadableStream<Response>;
+  cancel(): void;
+}
+
+export interface ClientWritableStream<Request, Response> {
+  write(request: Request): void;
+  end(): Promise<Response>;
+  cancel(): void;
+}
+
+export interface ClientDuplexStream<Request, Response> {
+  write(request: Request): void;
+  end(): void;
+  on(event: 'data', handler: (response: Response) => void): ClientDuplexStream<Request, Response>;
+  on(event: 'error', handler: (error: Error) => void): ClientDuplexStream<Request, Response>;
+  on(event: 'end', handler: () => void): ClientDuplexStream<Request, Response>;
+  cancel(): void;
+}
+`
+
+// methodTypeName returns the flowtype of a method request or response,
+// adding a dependency if it is defined outside of file.
+func (cfg GeneratorOptions) methodTypeName(file string, m *descriptor.Message, deps Dependencies) string {
+	if flowType, present := cfg.knownType(m.FQMN()); present {
+		return flowType
+	}
+	name := cfg.messageTypeName(m)
+	if m.File.GetName() != file {
+		deps.add(m.File.GetName(), name)
+	}
+	return name
+}
+
+// serviceToFlowType renders s as an interface with a Promise returning
+// function for unary methods and stream handles for streaming methods. The
+// returned bool reports whether any method is streaming.
+func (cfg GeneratorOptions) serviceToFlowType(s *descriptor.Service) (*serviceFlowType, Dependencies, bool) {
+	deps := Dependencies{}
+	t := &serviceFlowType{
+		name:    fmt.Sprintf("%sService", s.GetName()),
+		comment: cfg.comments.doc(s.FQSN(), ""),
+	}
+	streams := false
+	for _, m := range s.Methods {
+		req := cfg.methodTypeName(s.File.GetName(), m.RequestType, deps)
+		resp := cfg.methodTypeName(s.File.GetName(), m.ResponseType, deps)
+		name := lowerFirst(m.GetName())
+		var method string
+		switch {
+		case m.GetClientStreaming() && m.GetServerStreaming():
+			method = fmt.Sprintf("%s(): ClientDuplexStream<%s, %s>;", name, req, resp)
+		case m.GetClientStreaming():
+			method = fmt.Sprintf("%s(): ClientWritableStream<%s, %s>;", name, req, resp)
+		case m.GetServerStreaming():
+			method = fmt.Sprintf("%s(request: %s): ClientReadableStream<%s>;", name, req, resp)
+		default:
+			method = fmt.Sprintf("%s(request: %s): Promise<%s>;", name, req, resp)
+		}
+		streams = streams || m.GetClientStreaming() || m.GetServerStreaming()
+		t.methods = append(t.methods, cfg.comments.doc(fmt.Sprintf("%s.%s", s.FQSN(), m.GetName()), "  ")+"  "+method)
+	}
+	return t, deps, streams
+}
+
+func lowerFirst(s string) string {
+	if s == "" {
+		return s
+	}
+	r := []rune(s)
+	r[0] = unicode.ToLower(r[0])
+	return string(r)
+}
+
 func mergeDeps(dst, src Dependencies) {
 	if src != nil {
 		for p, types := range src {
@@ -287,13 +605,30 @@ func mergeDeps(dst, src Dependencies) {
 	}
 }
 
-func generateFlowTypes(file *descriptor.File, registry *descriptor.Registry, options GeneratorOptions) (string, error) {
-	if options.DumpJSON {
-		m := &jsonpb.Marshaler{EmitDefaults: true, OrigName: true, Indent: "  "}
-		m.Marshal(os.Stderr, file)
-		time.Sleep(time.Second)
+// importPath returns the path used to import the output generated for the
+// proto file dep from the output file name.
+func (cfg GeneratorOptions) importPath(name, dep string) string {
+	return relativeImport(name, cfg.outputName(dep))
+}
+
+// relativeImport returns the path importing the output file target from the
+// output file name.
+func relativeImport(name, target string) string {
+	rel, err := filepath.Rel(filepath.Dir(name), filepath.Dir(target))
+	if err != nil {
+		rel = filepath.Dir(target)
+	}
+	p := path.Join(filepath.ToSlash(rel), path.Base(filepath.ToSlash(target)))
+	if !strings.HasPrefix(p, ".") {
+		p = "./" + p
 	}
+	return p
+}
 
+// withFileOptions returns options with ProtoOptions set to the
+// (opts.field_defaults) of file, shared by the types and codecs generated
+// for it.
+func withFileOptions(file *descriptor.File, options GeneratorOptions) (GeneratorOptions, error) {
 	if file.Options != nil {
 		v, err := proto.GetExtension(file.Options, opts.E_FieldDefaults)
 		if err == nil {
@@ -302,10 +637,21 @@ func generateFlowTypes(file *descriptor.File, registry *descriptor.Registry, opt
 			}
 		} else {
 			if err != proto.ErrMissingExtension {
-				return "", errors.Wrap(err, "GetExtenstion")
+				return options, errors.Wrap(err, "GetExtenstion")
 			}
 		}
 	}
+	return options, nil
+}
+
+func generateFlowTypes(file *descriptor.File, registry *descriptor.Registry, options GeneratorOptions) (string, error) {
+	if options.DumpJSON {
+		m := &jsonpb.Marshaler{EmitDefaults: true, OrigName: true, Indent: "  "}
+		m.Marshal(os.Stderr, file)
+		time.Sleep(time.Second)
+	}
+
+	options.comments = fileComments(file.FileDescriptorProto)
 
 	deps := Dependencies{}
 	result := []FlowTyper{}
@@ -313,21 +659,97 @@ func generateFlowTypes(file *descriptor.File, registry *descriptor.Registry, opt
 	if err != nil {
 		return "", err
 	}
-	for _, enum := range f.Enums {
+	// nested names are flattened, so A.B and a top-level AB can collide
+	declaredBy := map[string]string{}
+	declare := func(name, fqn string) error {
+		if other, ok := declaredBy[name]; ok {
+			return fmt.Errorf("%s and %s are both declared as %s", other, fqn, name)
+		}
+		declaredBy[name] = fqn
+		return nil
+	}
+	addEnum := func(enum *descriptor.Enum) error {
+		if err := declare(options.enumTypeName(enum), enum.FQEN()); err != nil {
+			return err
+		}
 		t, newDeps, err := options.enumToFlowType(enum, registry)
 		if err != nil {
-			return "", err
+			return err
 		}
 		mergeDeps(deps, newDeps)
 		result = append(result, t)
+		return nil
 	}
-	for _, message := range f.Messages {
+	// messages are followed by their nested enums and messages
+	var addMessage func(message *descriptor.Message) error
+	addMessage = func(message *descriptor.Message) error {
+		if message.GetOptions().GetMapEntry() {
+			// map fields are rendered inline
+			return nil
+		}
+		if err := declare(options.messageTypeName(message), message.FQMN()); err != nil {
+			return err
+		}
 		t, newDeps, err := options.messageToFlowType(message, registry)
 		if err != nil {
-			return "", err
+			return err
 		}
 		mergeDeps(deps, newDeps)
 		result = append(result, t)
+		for _, e := range message.GetEnumType() {
+			enum, err := registry.LookupEnum("", fmt.Sprintf("%s.%s", message.FQMN(), e.GetName()))
+			if err != nil {
+				return err
+			}
+			if err := addEnum(enum); err != nil {
+				return err
+			}
+		}
+		for _, m := range message.GetNestedType() {
+			nested, err := registry.LookupMsg("", fmt.Sprintf("%s.%s", message.FQMN(), m.GetName()))
+			if err != nil {
+				return err
+			}
+			if err := addMessage(nested); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	for _, enum := range f.Enums {
+		if len(enum.Outers) > 0 {
+			continue
+		}
+		if err := addEnum(enum); err != nil {
+			return "", err
+		}
+	}
+	for _, message := range f.Messages {
+		if len(message.Outers) > 0 {
+			continue
+		}
+		if err := addMessage(message); err != nil {
+			return "", err
+		}
+	}
+
+	services := []*serviceFlowType{}
+	streams := false
+	for _, s := range f.Services {
+		t, newDeps, hasStreams := options.serviceToFlowType(s)
+		mergeDeps(deps, newDeps)
+		services = append(services, t)
+		streams = streams || hasStreams
+	}
+
+	imports := Dependencies{}
+	for dep, types := range deps {
+		for t := range types {
+			imports.add(options.importPath(options.outputName(file.GetName()), dep), t)
+		}
+	}
+	if options.usesBase64String(f) {
+		imports.add(relativeImport(options.outputName(file.GetName()), Base64StringFile), base64String)
 	}
 
 	buf := new(bytes.Buffer)
@@ -335,17 +757,19 @@ func generateFlowTypes(file *descriptor.File, registry *descriptor.Registry, opt
 /* eslint-disable */
 // Code generated by protoc-gen-flowtypes DO NOT EDIT.
 // InputID: {{.InputID}}
-{{ range $package, $types := .Dependencies -}}
+{{ range $path, $types := .Dependencies -}}
 import type {
 {{range $type, $true := $types}}  {{$type}},
 {{end -}}
-} from './{{ $package }}.js';
+} from '{{ $path }}';
 {{ end }}
 
-{{range .Result}}export type {{.Name}} = {{.FlowType}};
+{{range .Result}}{{.Comment}}export type {{.Name}} = {{.FlowType}};
+
+{{end}}{{if .Streams}}{{.StreamTypes}}
+{{end}}{{range .Services}}{{.Comment}}export interface {{.Name}} {{.FlowType}}
 
-{{end}}
-`)
+{{end}}`)
 	if err != nil {
 		return "", err
 	}
@@ -353,7 +777,17 @@ import type {
 		GeneratorOptions
 		Dependencies Dependencies
 		Result       []FlowTyper
-	}{GeneratorOptions: options, Dependencies: deps, Result: result})
+		Services     []*serviceFlowType
+		Streams      bool
+		StreamTypes  string
+	}{
+		GeneratorOptions: options,
+		Dependencies:     imports,
+		Result:           result,
+		Services:         services,
+		Streams:          streams,
+		StreamTypes:      streamTypes,
+	})
 	if err != nil {
 		return "", err
 	}
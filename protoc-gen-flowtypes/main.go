@@ -20,13 +20,45 @@ var (
 	importPrefix            = flag.String("import_prefix", "", "prefix to be added to go package paths for imported proto files")
 	flagAlwaysQualifyTypes  = flag.Bool("always_qualify_type_names", false, "prefixes package names to all types if true")
 	flagEmbedEnums          = flag.Bool("embed_enums", false, "embeds instead of creating references to enum types")
-	flagFilenameOverride    = flag.String("output", "", "output filename override")
+	flagOutPattern          = flag.String("outpattern", genflowtypes.DefaultOutPattern, "output filename pattern ({{.Dir}}, {{.BaseName}} and {{.Descriptor}} are available)")
+	flagFilenameOverride    = flag.String("output", "", "deprecated, use outpattern: '+' separated output filenames of the files to generate, in order")
 	flagOptionalSimpleTypes = flag.Bool("optional_simples", false, "marks default optionality for 'simple' field values")
 	flagEmitEnumZeros       = flag.Bool("enum_zeros", false, "emit enum names of value zero")
 	flagDumpJSON            = flag.Bool("dump_json", false, "dump json representation of request to stderr")
+	flagExactObjects        = flag.Bool("exact_objects", false, "use exact object types for messages")
+	flagReadOnly            = flag.Bool("readonly", false, "use covariant (read-only) properties and $ReadOnlyArray")
+	flagInt64AsString       = flag.Bool("int64_string", false, "use string representation for 64 bit numbers")
+	flagBytesAsBase64String = flag.Bool("bytes_base64", false, "use the opaque Base64String type for bytes")
+	flagCodecs              = flag.Bool("codecs", false, "generate <base>.codec.js files with decoders for JSON payloads")
+	flagFileDefaultsAll     = flag.Bool("file_defaults_all_fields", false, "apply the file level (opts.field_defaults) to scalar and message fields")
 	file                    = flag.String("file", "stdin", "where to load data from")
+	flagKnownTypes          = knownTypes{}
 )
 
+func init() {
+	flag.Var(flagKnownTypes, "known_type", "maps a proto type to a flowtype, as <.package.Type>:<flowtype> (repeatable)")
+}
+
+// knownTypes collects known_type parameters.
+type knownTypes map[string]string
+
+func (k knownTypes) String() string {
+	return fmt.Sprint(map[string]string(k))
+}
+
+func (k knownTypes) Set(value string) error {
+	spec := strings.SplitN(value, ":", 2)
+	if len(spec) != 2 || spec[0] == "" {
+		return fmt.Errorf("expected <.package.Type>:<flowtype>, got %q", value)
+	}
+	name := spec[0]
+	if !strings.HasPrefix(name, ".") {
+		name = "." + name
+	}
+	k[name] = spec[1]
+	return nil
+}
+
 func parseReq(r io.Reader) (*plugin.CodeGeneratorRequest, string, error) {
 	glog.V(1).Info("Parsing code generator request")
 	input, err := ioutil.ReadAll(r)
@@ -98,13 +130,21 @@ func main() {
 	}
 
 	out, err := g.Generate(targets, genflowtypes.GeneratorOptions{
-		AlwaysQualifyTypes: *flagAlwaysQualifyTypes,
-		EmbedEnums:         *flagEmbedEnums,
-		OptonalSimpleTypes: *flagOptionalSimpleTypes,
-		FilenameOverride:   *flagFilenameOverride,
-		EmitEnumZeros:      *flagEmitEnumZeros,
-		DumpJSON:           *flagDumpJSON,
-		InputID:            inputSha,
+		AlwaysQualifyTypes:    *flagAlwaysQualifyTypes,
+		EmbedEnums:            *flagEmbedEnums,
+		OptonalSimpleTypes:    *flagOptionalSimpleTypes,
+		OutPattern:            *flagOutPattern,
+		FilenameOverride:      *flagFilenameOverride,
+		EmitEnumZeros:         *flagEmitEnumZeros,
+		DumpJSON:              *flagDumpJSON,
+		ExactObjects:          *flagExactObjects,
+		ReadOnly:              *flagReadOnly,
+		KnownTypes:            flagKnownTypes,
+		Int64AsString:         *flagInt64AsString,
+		BytesAsBase64String:   *flagBytesAsBase64String,
+		Codecs:                *flagCodecs,
+		FileDefaultsAllFields: *flagFileDefaultsAll,
+		InputID:               inputSha,
 	})
 
 	glog.V(1).Info("Processed code generator request")
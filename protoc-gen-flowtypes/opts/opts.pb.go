@@ -77,6 +77,15 @@ var E_FieldDefaults = &proto.ExtensionDesc{
 	Filename:      "opts.proto",
 }
 
+var E_MessageFieldDefaults = &proto.ExtensionDesc{
+	ExtendedType:  (*descriptor.MessageOptions)(nil),
+	ExtensionType: (*Options)(nil),
+	Field:         1035,
+	Name:          "opts.message_field_defaults",
+	Tag:           "bytes,1035,opt,name=message_field_defaults",
+	Filename:      "opts.proto",
+}
+
 var E_Field = &proto.ExtensionDesc{
 	ExtendedType:  (*descriptor.FieldOptions)(nil),
 	ExtensionType: (*Options)(nil),
@@ -89,13 +98,14 @@ var E_Field = &proto.ExtensionDesc{
 func init() {
 	proto.RegisterType((*Options)(nil), "opts.Options")
 	proto.RegisterExtension(E_FieldDefaults)
+	proto.RegisterExtension(E_MessageFieldDefaults)
 	proto.RegisterExtension(E_Field)
 }
 
 func init() { proto.RegisterFile("opts.proto", fileDescriptor_f695bd055fd0de95) }
 
 var fileDescriptor_f695bd055fd0de95 = []byte{
-	// 186 bytes of a gzipped FileDescriptorProto
+	// 212 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xe2, 0xca, 0x2f, 0x28, 0x29,
 	0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x62, 0x01, 0xb1, 0xa5, 0x14, 0xd2, 0xf3, 0xf3, 0xd3,
 	0x73, 0x52, 0xf5, 0xc1, 0x62, 0x49, 0xa5, 0x69, 0xfa, 0x29, 0xa9, 0xc5, 0xc9, 0x45, 0x99, 0x05,
@@ -105,7 +115,9 @@ var fileDescriptor_f695bd055fd0de95 = []byte{
 	0x09, 0x22, 0x07, 0xe3, 0x5b, 0x05, 0x73, 0xf1, 0xa5, 0x65, 0xa6, 0xe6, 0xa4, 0xc4, 0xa7, 0xa4,
 	0xa6, 0x25, 0x96, 0xe6, 0x94, 0x14, 0x0b, 0xc9, 0xe8, 0x41, 0xec, 0xd5, 0x83, 0xd9, 0xab, 0xe7,
 	0x96, 0x99, 0x93, 0x0a, 0xb5, 0x47, 0xa2, 0x9b, 0x43, 0x81, 0x51, 0x83, 0xdb, 0x88, 0x57, 0x0f,
-	0xec, 0x5c, 0xa8, 0x68, 0x10, 0x2f, 0xd8, 0x0c, 0x17, 0xa8, 0x11, 0x56, 0xce, 0x5c, 0xac, 0x60,
-	0x01, 0x21, 0x59, 0x2c, 0x66, 0xa5, 0xe6, 0xa4, 0xe0, 0x37, 0x0c, 0xa2, 0x17, 0x10, 0x00, 0x00,
-	0xff, 0xff, 0xa2, 0x5d, 0xfc, 0x8f, 0x11, 0x01, 0x00, 0x00,
+	0xec, 0x5c, 0xa8, 0x68, 0x10, 0x2f, 0xd8, 0x0c, 0x17, 0xa8, 0x11, 0x56, 0xa9, 0x5c, 0x62, 0xb9,
+	0xa9, 0xc5, 0xc5, 0x89, 0xe9, 0xa9, 0xf1, 0x68, 0x86, 0xcb, 0x63, 0x18, 0xee, 0x0b, 0x51, 0x88,
+	0xdf, 0x7c, 0x11, 0xa8, 0x71, 0x6e, 0x28, 0xd6, 0x38, 0x73, 0xb1, 0x82, 0x8d, 0x17, 0x92, 0xc5,
+	0xe2, 0xe4, 0xd4, 0x9c, 0x14, 0xfc, 0x66, 0x42, 0xf4, 0x02, 0x06, 0x00, 0xdd, 0xaf, 0x29, 0xc4,
+	0x78, 0x01, 0x00, 0x00,
 }
@@ -25,13 +25,30 @@
 //
 // The following options are available:
 //  declare_namespace: declare namespace for the generated type (default true)
+//  declare_module: wrap declarations in `declare module "<name>"`, named by the (opts.ts_module) file option or go_package (default false)
+//   (types declared in other modules are referenced as import("<name>").<type>)
 //  original_names: use original field names, otherwise use lowerCamelCase (default false)
 //  int_enums: use ints instead of strings for enums (default false)
-//  outpattern: control the output file paths.
+//  outpattern: control the output file paths ({{.Dir}}, {{.BaseName}}, {{.ModuleName}} and {{.Descriptor}} are available).
 //  async_iterators: use async iterators for streaming endpoint types (default false)
 //  int64_string: use string representation for 64 bit numbers (default false)
+//  readonly: declare properties readonly and use ReadonlyArray / Readonly<Record> for repeated and map fields (default false)
+//  readonly_outputs_only: with readonly, only apply it to messages used as method outputs and the messages they contain (default false)
+//  field_paths: generate an XFieldPath union of the dotted field mask paths of each message (default false)
+//  field_path_depth: maximum depth of paths generated by field_paths (default 3)
+//  M<file>=<module>: reference types from <file> as imports from <module> instead of generating them
+//   (with declare_namespace and without declare_module, <module> holds global namespaces, which are
+//   loaded by a /// <reference types="<module>" /> directive and referenced by name)
 // An example of running with a custom option set:
 //  protoc -I. --tstypes_out=original_names=true,async_iterators=true:. route_guide.proto
+// Types from dependencies generated elsewhere can be mapped to published modules:
+//  protoc -I. --tstypes_out=Mgoogle/protobuf/timestamp.proto=@scope/wkt:. example1.proto
+//
+// Names
+//
+// Declarations named after TypeScript reserved words or global types (such as Object, Promise or Array),
+// and declarations whose flattened names collide within a namespace (such as A.B and A_B), are escaped
+// by appending "_". Renames are reported on stderr when v=1.
 //
 // examples.sh contains more complex examples and generated output can be seen at https://github.com/gabriel/grpcutil/blob/master/protoc-gen-tstypes/testdata/output
 //
@@ -4,7 +4,7 @@ set -x
 
 cd testdata
 rm -fr output/*
-ds=(output/defaults output/int-enums output/camel-case-names output/outpattern-{1,2,3} output/wo-namespace output/async-iterators)
+ds=(output/defaults output/int-enums output/camel-case-names output/outpattern-{1,2,3} output/wo-namespace output/async-iterators output/import-map output/declare-module output/readonly output/readonly-outputs-only output/field-paths output/field-path-depth)
 
 # GOPATH src root relative to the testdata directory
 GOPATH_ROOT="../../../../../"
@@ -20,6 +20,12 @@ for e in ./*proto; do
     protoc -I. -I${GOPATH_ROOT} -I${GOOGLEAPIS_ROOT} --tstypes_out 'v=1,outpattern={{.Dir}}/{{.BaseName}}pb.d.ts:output/outpattern-3/' "${e}"
     protoc -I. -I${GOPATH_ROOT} -I${GOOGLEAPIS_ROOT} --tstypes_out=v=1,declare_namespace=false:output/wo-namespace/ "${e}"
     protoc -I. -I${GOPATH_ROOT} -I${GOOGLEAPIS_ROOT} --tstypes_out=v=1,async_iterators=true:output/async-iterators/ "${e}"
+    protoc -I. -I${GOPATH_ROOT} -I${GOOGLEAPIS_ROOT} --tstypes_out=v=1,Mgoogle/protobuf/timestamp.proto=@scope/wkt:output/import-map/ "${e}"
+    protoc -I. -I${GOPATH_ROOT} -I${GOOGLEAPIS_ROOT} --tstypes_out=v=1,declare_module=true:output/declare-module/ "${e}"
+    protoc -I. -I${GOPATH_ROOT} -I${GOOGLEAPIS_ROOT} --tstypes_out=v=1,readonly=true:output/readonly/ "${e}"
+    protoc -I. -I${GOPATH_ROOT} -I${GOOGLEAPIS_ROOT} --tstypes_out=v=1,readonly=true,readonly_outputs_only=true:output/readonly-outputs-only/ "${e}"
+    protoc -I. -I${GOPATH_ROOT} -I${GOOGLEAPIS_ROOT} --tstypes_out=v=1,field_paths=true:output/field-paths/ "${e}"
+    protoc -I. -I${GOPATH_ROOT} -I${GOOGLEAPIS_ROOT} --tstypes_out=v=1,field_paths=true,field_path_depth=1:output/field-path-depth/ "${e}"
 done
 
 if [ "${CHECK:-}" != "0" ]; then
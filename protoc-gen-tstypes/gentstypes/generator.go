@@ -32,12 +32,25 @@ type FieldOptionsFunc = func(MessageOptions, *desc.FieldDescriptor) FieldOptions
 type Parameters struct {
 	AsyncIterators        bool
 	DeclareNamespace      bool
+	DeclareModule         bool
 	OutputNamePattern     string
 	DumpRequestDescriptor bool
 	EnumsAsInt            bool
 	OriginalNames         bool
 	Verbose               int
 	Int64AsString         bool
+	// Readonly declares message properties, arrays and maps as readonly.
+	Readonly bool
+	// ReadonlyOutputsOnly restricts Readonly to messages used as method
+	// outputs and the messages reachable from them.
+	ReadonlyOutputsOnly bool
+	// FieldPaths generates a string literal union type of the valid field
+	// mask paths for each message, descending FieldPathDepth levels.
+	FieldPaths     bool
+	FieldPathDepth int
+	// ImportMap maps proto file names to the modules their types are
+	// imported from. Mapped files are not generated.
+	ImportMap map[string]string
 	// TODO: allow template specification?
 
 	MessageOptionsFunc MessageOptionsFunc
@@ -49,11 +62,19 @@ type Generator struct {
 	indent   string
 	Request  *plugin.CodeGeneratorRequest
 	Response *plugin.CodeGeneratorResponse
+
+	// outputs holds the fully qualified names of messages reachable from method outputs.
+	outputs map[string]bool
+	names   *nameTable
+	// references holds the mapped modules whose global declarations the
+	// file being generated refers to.
+	references map[string]bool
 }
 
 type OutputNameContext struct {
 	Dir        string
 	BaseName   string
+	ModuleName string
 	Descriptor *desc.FileDescriptor
 	Request    *plugin.CodeGeneratorRequest
 }
@@ -110,9 +131,22 @@ var s = &spew.ConfigState{
 	DisableCapacities:       true,
 }
 
-func genName(r *plugin.CodeGeneratorRequest, f *desc.FileDescriptor, outPattern string) string {
-	// TODO: consider using go_package if present?
+// moduleName returns the name used for an ambient module declaration of f.
+// The ts_module file option takes precedence over go_package.
+func moduleName(f *desc.FileDescriptor) string {
+	if o, err := proto.GetExtension(f.AsFileDescriptorProto().Options, opts.E_TsModule); err == nil {
+		if name, ok := o.(*string); ok && *name != "" {
+			return *name
+		}
+	}
+	pkg := f.GetFileOptions().GetGoPackage()
+	if i := strings.Index(pkg, ";"); i >= 0 {
+		pkg = pkg[:i]
+	}
+	return pkg
+}
 
+func genName(r *plugin.CodeGeneratorRequest, f *desc.FileDescriptor, outPattern string) string {
 	n := filepath.Base(f.GetName())
 	if strings.HasSuffix(n, ".proto") {
 		n = n[:len(n)-len(".proto")]
@@ -120,6 +154,7 @@ func genName(r *plugin.CodeGeneratorRequest, f *desc.FileDescriptor, outPattern
 	ctx := &OutputNameContext{
 		Dir:        filepath.Dir(f.GetName()),
 		BaseName:   n,
+		ModuleName: moduleName(f),
 		Descriptor: f,
 		Request:    r,
 	}
@@ -145,17 +180,92 @@ func (g *Generator) GenerateAllFiles(params *Parameters) {
 		names = append(names, fname)
 	}
 	sort.Strings(names)
+	g.names = newNameTable(params.Verbose > 0)
+	all := []string{}
+	for n := range files {
+		all = append(all, n)
+	}
+	sort.Strings(all)
+	registered := []*desc.FileDescriptor{}
+	for _, n := range all {
+		registered = append(registered, files[n])
+	}
+	g.register(registered, params)
+	if params.Readonly && params.ReadonlyOutputsOnly {
+		targets := []*desc.FileDescriptor{}
+		for _, n := range names {
+			targets = append(targets, files[n])
+		}
+		g.outputs = outputMessages(targets)
+	}
 	for _, n := range names {
+		if mod, ok := params.ImportMap[n]; ok {
+			if params.Verbose > 0 {
+				fmt.Fprintln(os.Stderr, "skipping", n, "mapped to", mod)
+			}
+			continue
+		}
 		f := files[n]
 		g.generate(f, params)
 	}
 }
 
+// outputMessages returns the fully qualified names of messages used as
+// method outputs in files, along with messages reachable from them through fields.
+func outputMessages(files []*desc.FileDescriptor) map[string]bool {
+	result := map[string]bool{}
+	var visit func(m *desc.MessageDescriptor)
+	visit = func(m *desc.MessageDescriptor) {
+		if result[m.GetFullyQualifiedName()] {
+			return
+		}
+		result[m.GetFullyQualifiedName()] = true
+		for _, f := range m.GetFields() {
+			if t := f.GetMessageType(); t != nil {
+				visit(t)
+			}
+		}
+	}
+	for _, f := range files {
+		for _, s := range f.GetServices() {
+			for _, m := range s.GetMethods() {
+				visit(m.GetOutputType())
+			}
+		}
+	}
+	return result
+}
+
+func (g *Generator) isReadonly(m *desc.MessageDescriptor, params *Parameters) bool {
+	if !params.Readonly {
+		return false
+	}
+	return !params.ReadonlyOutputsOnly || g.outputs[m.GetFullyQualifiedName()]
+}
+
 func (g *Generator) generate(f *desc.FileDescriptor, params *Parameters) {
 	// TODO: consider best order
+	start := g.Len()
+	g.references = map[string]bool{}
+	mod := ""
+	if params.DeclareModule {
+		mod = moduleName(f)
+		if mod == "" && params.Verbose > 0 {
+			fmt.Fprintln(os.Stderr, "no module name for", f.GetName())
+		}
+	}
+	if mod != "" {
+		g.W(fmt.Sprintf("declare module \"%s\" {\n", mod))
+		g.incIndent()
+	}
 	ns := params.DeclareNamespace && f.GetPackage() != ""
 	if ns {
-		g.W(fmt.Sprintf("declare namespace %s {\n", f.GetPackage()))
+		// namespaces inside a module declaration are already ambient
+		decl := "declare namespace"
+		if mod != "" {
+			decl = "export namespace"
+		}
+		g.W(fmt.Sprintf("%s %s {\n", decl, g.names.namespace(f.GetPackage())))
 		g.incIndent()
 	}
 
@@ -167,13 +277,21 @@ func (g *Generator) generate(f *desc.FileDescriptor, params *Parameters) {
 		g.decIndent()
 		g.W("}\n")
 	}
+	if mod != "" {
+		g.decIndent()
+		g.W("}\n")
+	}
 	n := genName(g.Request, f, params.OutputNamePattern)
 	if params.Verbose > 0 {
 		fmt.Fprintln(os.Stderr, "generating", n)
 	}
+	content := g.String()
+	if len(g.references) > 0 {
+		content = content[:start] + referenceDirectives(g.references) + content[start:]
+	}
 	g.Response.File = append(g.Response.File, &plugin.CodeGeneratorResponse_File{
 		Name:    proto.String(n),
-		Content: proto.String(g.String()),
+		Content: proto.String(content),
 	})
 	g.Buffer.Reset()
 }
@@ -236,13 +354,19 @@ func (g *Generator) generateMessage(m *desc.MessageDescriptor, params *Parameter
 	for _, m := range m.GetNestedMessageTypes() {
 		g.generateMessage(m, params)
 	}
-	name := packageQualifiedName(m)
+	name := g.typeName(m)
 
 	mOpts := DefaultMessageOptionsFunc(m)
 	if params.MessageOptionsFunc != nil {
 		mOpts = params.MessageOptionsFunc(m)
 	}
 
+	readonly := g.isReadonly(m, params)
+	modifier := ""
+	if readonly {
+		modifier = "readonly "
+	}
+
 	g.wcomment(m.GetSourceInfo().GetLeadingComments())
 	g.W(fmt.Sprintf("export interface %s {", name))
 	for _, f := range m.GetFields() {
@@ -269,23 +393,73 @@ func (g *Generator) generateMessage(m *desc.MessageDescriptor, params *Parameter
 		if comment := f.GetSourceInfo().GetTrailingComments(); comment != "" {
 			trailingComment = " // " + strings.TrimSpace(comment)
 		}
-		g.W(fmt.Sprintf(indent+"%s%s: %s;%s", name, suffix, fieldType(f, params), trailingComment))
+		g.W(fmt.Sprintf(indent+"%s%s%s: %s;%s", modifier, name, suffix, g.fieldType(f, params, readonly), trailingComment))
 	}
 	g.W("}\n")
+	if params.FieldPaths && !m.IsMapEntry() {
+		g.generateFieldPaths(m, params)
+	}
+}
+
+func (g *Generator) generateFieldPaths(m *desc.MessageDescriptor, params *Parameters) {
+	paths := fieldPaths(m, params, params.FieldPathDepth)
+	name := g.names.ids[m.GetFullyQualifiedName()+"#FieldPath"]
+	if len(paths) == 0 {
+		g.W(fmt.Sprintf("export type %s = never;\n", name))
+		return
+	}
+	g.W(fmt.Sprintf("export type %s =", name))
+	for i, p := range paths {
+		end := ""
+		if i == len(paths)-1 {
+			end = ";\n"
+		}
+		g.W(fmt.Sprintf(indent+"| \"%s\"%s", p, end))
+	}
 }
 
-func fieldType(f *desc.FieldDescriptor, params *Parameters) string {
-	t := rawFieldType(f, params)
+// fieldPaths returns the dotted field paths of m up to depth levels deep.
+// Only singular message fields are descended into, as field masks do not
+// allow repeated fields other than in the last position.
+func fieldPaths(m *desc.MessageDescriptor, params *Parameters, depth int) []string {
+	if depth <= 0 {
+		return nil
+	}
+	paths := []string{}
+	for _, f := range m.GetFields() {
+		name := f.GetName()
+		if !params.OriginalNames {
+			name = f.GetJSONName()
+		}
+		paths = append(paths, name)
+		if t := f.GetMessageType(); t != nil && !f.IsRepeated() {
+			for _, p := range fieldPaths(t, params, depth-1) {
+				paths = append(paths, name+"."+p)
+			}
+		}
+	}
+	return paths
+}
+
+func (g *Generator) fieldType(f *desc.FieldDescriptor, params *Parameters, readonly bool) string {
+	t := g.rawFieldType(f, params)
 	if f.IsMap() {
-		return fmt.Sprintf("{ [key: %s]: %s }", rawFieldType(f.GetMapKeyType(), params), rawFieldType(f.GetMapValueType(), params))
+		k, v := g.rawFieldType(f.GetMapKeyType(), params), g.rawFieldType(f.GetMapValueType(), params)
+		if readonly {
+			return fmt.Sprintf("Readonly<Record<%s, %s>>", k, v)
+		}
+		return fmt.Sprintf("{ [key: %s]: %s }", k, v)
 	}
 	if f.IsRepeated() {
+		if readonly {
+			return fmt.Sprintf("ReadonlyArray<%s>", t)
+		}
 		return fmt.Sprintf("Array<%s>", t)
 	}
 	return t
 }
 
-func rawFieldType(f *desc.FieldDescriptor, params *Parameters) string {
+func (g *Generator) rawFieldType(f *desc.FieldDescriptor, params *Parameters) string {
 	switch f.GetType() {
 	case descriptor.FieldDescriptorProto_TYPE_DOUBLE:
 		fallthrough
@@ -322,21 +496,62 @@ func rawFieldType(f *desc.FieldDescriptor, params *Parameters) string {
 	case descriptor.FieldDescriptorProto_TYPE_BYTES:
 		return "Uint8Array"
 	case descriptor.FieldDescriptorProto_TYPE_ENUM:
-		t := f.GetEnumType()
-		if t.GetFile().GetPackage() != f.GetFile().GetPackage() {
-			return t.GetFullyQualifiedName()
-		}
-		return packageQualifiedName(t)
+		return g.typeRef(f.GetFile(), f.GetEnumType(), params)
 	case descriptor.FieldDescriptorProto_TYPE_MESSAGE:
-		t := f.GetMessageType()
-		if t.GetFile().GetPackage() != f.GetFile().GetPackage() {
-			return t.GetFullyQualifiedName()
-		}
-		return packageQualifiedName(t)
+		return g.typeRef(f.GetFile(), f.GetMessageType(), params)
 	}
 	return "any /*unknown*/"
 }
 
+// referenceDirectives returns the triple-slash directives loading the
+// declarations of modules.
+func referenceDirectives(modules map[string]bool) string {
+	sorted := []string{}
+	for mod := range modules {
+		sorted = append(sorted, mod)
+	}
+	sort.Strings(sorted)
+	buf := new(bytes.Buffer)
+	for _, mod := range sorted {
+		fmt.Fprintf(buf, "/// <reference types=\"%s\" />\n", mod)
+	}
+	buf.WriteString("\n")
+	return buf.String()
+}
+
+// typeRef returns the name used to refer to t from declarations generated for file.
+// Types defined in files mapped by params.ImportMap are referenced through an import type,
+// unless declarations are global namespaces, which are referenced by name after loading
+// the mapped module with a reference directive. With declare_module, types declared in
+// another module are referenced through an import type too.
+func (g *Generator) typeRef(file *desc.FileDescriptor, t desc.Descriptor, params *Parameters) string {
+	if mod, ok := params.ImportMap[t.GetFile().GetName()]; ok && t.GetFile() != file {
+		if params.DeclareNamespace && !params.DeclareModule {
+			g.references[mod] = true
+			return g.qualifiedName(t)
+		}
+		return g.importTypeRef(mod, t, params)
+	}
+	if params.DeclareModule && t.GetFile() != file {
+		if mod := moduleName(t.GetFile()); mod != "" && mod != moduleName(file) {
+			return g.importTypeRef(mod, t, params)
+		}
+	}
+	if t.GetFile().GetPackage() != file.GetPackage() {
+		return g.qualifiedName(t)
+	}
+	return g.typeName(t)
+}
+
+// importTypeRef returns an import type referring to t as declared by the module mod.
+func (g *Generator) importTypeRef(mod string, t desc.Descriptor, params *Parameters) string {
+	name := g.typeName(t)
+	if params.DeclareNamespace {
+		name = g.qualifiedName(t)
+	}
+	return fmt.Sprintf("import(\"%s\").%s", mod, name)
+}
+
 func packageQualifiedName(e desc.Descriptor) string {
 	name := e.GetName()
 	var c desc.Descriptor
@@ -347,7 +562,7 @@ func packageQualifiedName(e desc.Descriptor) string {
 }
 
 func (g *Generator) generateEnum(e *desc.EnumDescriptor, params *Parameters) {
-	name := packageQualifiedName(e)
+	name := g.typeName(e)
 	g.W(fmt.Sprintf("export enum %s {", name))
 	for _, v := range e.GetValues() {
 		if params.EnumsAsInt {
@@ -360,7 +575,7 @@ func (g *Generator) generateEnum(e *desc.EnumDescriptor, params *Parameters) {
 }
 
 func (g *Generator) generateService(service *desc.ServiceDescriptor, params *Parameters) {
-	g.W(fmt.Sprintf("export interface %sService {", service.GetName()))
+	g.W(fmt.Sprintf("export interface %s {", g.names.ids[service.GetFullyQualifiedName()+"#Service"]))
 	g.incIndent()
 	g.generateServiceMethods(service, params)
 	g.decIndent()
@@ -373,8 +588,8 @@ func (g *Generator) generateServiceMethods(service *desc.ServiceDescriptor, para
 	}
 }
 func (g *Generator) generateServiceMethod(method *desc.MethodDescriptor, params *Parameters) {
-	i := method.GetInputType().GetName()
-	o := method.GetOutputType().GetName()
+	i := g.typeName(method.GetInputType())
+	o := g.typeName(method.GetOutputType())
 	if params.AsyncIterators {
 		if method.IsServerStreaming() {
 			o = fmt.Sprintf("AsyncIterator<%s>", o)
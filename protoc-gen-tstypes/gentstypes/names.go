@@ -0,0 +1,143 @@
+package gentstypes
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/jhump/protoreflect/desc"
+)
+
+// reservedNames holds TypeScript reserved words along with global types that
+// generated declarations refer to or would commonly shadow.
+var reservedNames = map[string]bool{
+	// reserved words
+	"break": true, "case": true, "catch": true, "class": true, "const": true,
+	"continue": true, "debugger": true, "default": true, "delete": true, "do": true,
+	"else": true, "enum": true, "export": true, "extends": true, "false": true,
+	"finally": true, "for": true, "function": true, "if": true, "import": true,
+	"in": true, "instanceof": true, "new": true, "null": true, "return": true,
+	"super": true, "switch": true, "this": true, "throw": true, "true": true,
+	"try": true, "typeof": true, "var": true, "void": true, "while": true,
+	"with": true,
+	// strict mode reserved words
+	"implements": true, "interface": true, "let": true, "package": true,
+	"private": true, "protected": true, "public": true, "static": true,
+	"yield": true, "await": true,
+	// predefined type names
+	"any": true, "boolean": true, "never": true, "number": true, "object": true,
+	"string": true, "symbol": true, "undefined": true, "unknown": true,
+	// global types
+	"Array": true, "AsyncIterator": true, "Boolean": true, "Date": true,
+	"Error": true, "Function": true, "Map": true, "Number": true, "Object": true,
+	"Promise": true, "Readonly": true, "ReadonlyArray": true, "Record": true,
+	"Set": true, "String": true, "Symbol": true, "Uint8Array": true,
+}
+
+// nameTable assigns TypeScript identifiers to proto declarations. Names that
+// are reserved or already taken within a scope are escaped by appending "_".
+type nameTable struct {
+	ids     map[string]string
+	taken   map[string]map[string]bool
+	verbose bool
+}
+
+func newNameTable(verbose bool) *nameTable {
+	return &nameTable{
+		ids:     map[string]string{},
+		taken:   map[string]map[string]bool{},
+		verbose: verbose,
+	}
+}
+
+// add assigns an identifier for key within scope, preferring name.
+// Adding an existing key returns the identifier assigned before.
+func (t *nameTable) add(scope, key, name string) string {
+	if id, ok := t.ids[key]; ok {
+		return id
+	}
+	if t.taken[scope] == nil {
+		t.taken[scope] = map[string]bool{}
+	}
+	id := name
+	for reservedNames[id] || t.taken[scope][id] {
+		id += "_"
+	}
+	if id != name && t.verbose {
+		fmt.Fprintf(os.Stderr, "renaming %s to %s\n", key, id)
+	}
+	t.taken[scope][id] = true
+	t.ids[key] = id
+	return id
+}
+
+// namespace returns pkg with reserved segments escaped.
+func (t *nameTable) namespace(pkg string) string {
+	parts := strings.Split(pkg, ".")
+	for i, p := range parts {
+		parts[i] = t.add("package:"+strings.Join(parts[:i], "."), "package:"+strings.Join(parts[:i+1], "."), p)
+	}
+	return strings.Join(parts, ".")
+}
+
+// register assigns identifiers to the declarations of files. Top level
+// declarations are added before nested ones so that they keep their names.
+func (g *Generator) register(files []*desc.FileDescriptor, params *Parameters) {
+	for _, f := range files {
+		scope := ""
+		if params.DeclareNamespace {
+			scope = f.GetPackage()
+		}
+		g.names.namespace(f.GetPackage())
+		enums := f.GetEnumTypes()
+		messages := f.GetMessageTypes()
+		for len(enums) > 0 || len(messages) > 0 {
+			nestedMessages := []*desc.MessageDescriptor{}
+			nestedEnums := []*desc.EnumDescriptor{}
+			for _, e := range enums {
+				g.names.add(scope, e.GetFullyQualifiedName(), packageQualifiedName(e))
+			}
+			for _, m := range messages {
+				g.names.add(scope, m.GetFullyQualifiedName(), packageQualifiedName(m))
+				nestedEnums = append(nestedEnums, m.GetNestedEnumTypes()...)
+				nestedMessages = append(nestedMessages, m.GetNestedMessageTypes()...)
+			}
+			enums, messages = nestedEnums, nestedMessages
+		}
+		for _, s := range f.GetServices() {
+			g.names.add(scope, s.GetFullyQualifiedName()+"#Service", s.GetName()+"Service")
+		}
+	}
+	if params.FieldPaths {
+		for _, f := range files {
+			scope := ""
+			if params.DeclareNamespace {
+				scope = f.GetPackage()
+			}
+			var visit func(messages []*desc.MessageDescriptor)
+			visit = func(messages []*desc.MessageDescriptor) {
+				for _, m := range messages {
+					visit(m.GetNestedMessageTypes())
+					g.names.add(scope, m.GetFullyQualifiedName()+"#FieldPath", g.typeName(m)+"FieldPath")
+				}
+			}
+			visit(f.GetMessageTypes())
+		}
+	}
+}
+
+// typeName returns the identifier declared for e within its namespace.
+func (g *Generator) typeName(e desc.Descriptor) string {
+	if id, ok := g.names.ids[e.GetFullyQualifiedName()]; ok {
+		return id
+	}
+	return packageQualifiedName(e)
+}
+
+// qualifiedName returns the namespace prefixed identifier of e.
+func (g *Generator) qualifiedName(e desc.Descriptor) string {
+	if pkg := e.GetFile().GetPackage(); pkg != "" {
+		return g.names.namespace(pkg) + "." + g.typeName(e)
+	}
+	return g.typeName(e)
+}
@@ -16,12 +16,20 @@ import (
 var (
 	flagVerbose               = flag.Int("v", 0, "verbosity level")
 	flagDeclareNamespace      = flag.Bool("declare_namespace", true, "if true, generate a namespace declaration")
+	flagDeclareModule         = flag.Bool("declare_module", false, "if true, generate an ambient module declaration named by the ts_module option or go_package")
 	flagAsyncIterators        = flag.Bool("async_iterators", false, "if true, user async iterators")
 	flagEnumsAsInts           = flag.Bool("int_enums", false, "if true, generate numeric enums")
 	flagOriginalNames         = flag.Bool("original_names", true, "if true, use original proto file field names, otherwise convert to lowerCamelCase")
 	flagOutputFilenamePattern = flag.String("outpattern", "{{.Dir}}/{{.Descriptor.GetPackage | default \"none\"}}.{{.BaseName}}.d.ts", "output filename pattern")
 	flagDumpDescriptor        = flag.Bool("dump_request_descriptor", false, "if true, dump request descriptor")
 	flagInt64AsString         = flag.Bool("int64_string", false, "if true, use string representation for 64 bit numbers")
+	flagReadonly              = flag.Bool("readonly", false, "if true, declare message properties, arrays and maps as readonly")
+	flagReadonlyOutputsOnly   = flag.Bool("readonly_outputs_only", false, "if true, only apply readonly to messages reachable from method outputs")
+	flagFieldPaths            = flag.Bool("field_paths", false, "if true, generate field mask path types for messages")
+	flagFieldPathDepth        = flag.Int("field_path_depth", 3, "maximum depth of generated field mask paths")
+
+	// importMap holds M parameters mapping proto files to the modules providing their types.
+	importMap = map[string]string{}
 )
 
 func main() {
@@ -44,12 +52,18 @@ func main() {
 	g.GenerateAllFiles(&gentstypes.Parameters{
 		AsyncIterators:        *flagAsyncIterators,
 		DeclareNamespace:      *flagDeclareNamespace,
+		DeclareModule:         *flagDeclareModule,
 		Verbose:               *flagVerbose,
 		OutputNamePattern:     *flagOutputFilenamePattern,
 		EnumsAsInt:            *flagEnumsAsInts,
 		OriginalNames:         *flagOriginalNames,
 		DumpRequestDescriptor: *flagDumpDescriptor,
 		Int64AsString:         *flagInt64AsString,
+		Readonly:              *flagReadonly,
+		ReadonlyOutputsOnly:   *flagReadonlyOutputsOnly,
+		FieldPaths:            *flagFieldPaths,
+		FieldPathDepth:        *flagFieldPathDepth,
+		ImportMap:             importMap,
 	})
 	data, err = proto.Marshal(g.Response)
 	if err != nil {
@@ -74,7 +88,10 @@ func parseFlags(s *string) {
 			continue
 		}
 		name, value := spec[0], spec[1]
-		// TODO: consider supporting package mapping (M flag)
+		if strings.HasPrefix(name, "M") {
+			importMap[name[1:]] = value
+			continue
+		}
 		if err := flag.CommandLine.Set(name, value); err != nil {
 			log.Fatalln("Cannot set flag", p)
 		}
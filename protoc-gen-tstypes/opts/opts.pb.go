@@ -70,6 +70,15 @@ func (m *Options) GetFieldBehavior() annotations.FieldBehavior {
 	return annotations.FieldBehavior_FIELD_BEHAVIOR_UNSPECIFIED
 }
 
+var E_TsModule = &proto.ExtensionDesc{
+	ExtendedType:  (*descriptor.FileOptions)(nil),
+	ExtensionType: (*string)(nil),
+	Field:         1035,
+	Name:          "opts.ts_module",
+	Tag:           "bytes,1035,opt,name=ts_module",
+	Filename:      "opts.proto",
+}
+
 var E_FieldDefaults = &proto.ExtensionDesc{
 	ExtendedType:  (*descriptor.MessageOptions)(nil),
 	ExtensionType: (*Options)(nil),
@@ -90,6 +99,7 @@ var E_Field = &proto.ExtensionDesc{
 
 func init() {
 	proto.RegisterType((*Options)(nil), "opts.Options")
+	proto.RegisterExtension(E_TsModule)
 	proto.RegisterExtension(E_FieldDefaults)
 	proto.RegisterExtension(E_Field)
 }
@@ -99,19 +109,21 @@ func init() {
 }
 
 var fileDescriptor_f695bd055fd0de95 = []byte{
-	// 218 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x8f, 0xb1, 0x4a, 0xc5, 0x30,
-	0x18, 0x85, 0x89, 0x28, 0x96, 0x48, 0x3b, 0x64, 0xaa, 0x05, 0x69, 0x70, 0xea, 0x94, 0x42, 0xc7,
-	0x4e, 0xa2, 0xe2, 0x26, 0x42, 0x07, 0x57, 0x49, 0xcd, 0x9f, 0x18, 0x28, 0x26, 0x26, 0xa9, 0x2f,
-	0xe1, 0x4b, 0x4b, 0xd3, 0x44, 0xbc, 0x5c, 0xb8, 0x5b, 0xff, 0xc3, 0x77, 0xbe, 0x9e, 0x60, 0x6c,
-	0x6c, 0xf0, 0xcc, 0x3a, 0x13, 0x0c, 0x39, 0xdf, 0xbe, 0x1b, 0xaa, 0x8c, 0x51, 0x0b, 0xf4, 0x31,
-	0x9b, 0x57, 0xd9, 0x0b, 0xf0, 0xef, 0x4e, 0xdb, 0x60, 0xdc, 0xce, 0x35, 0x6d, 0x22, 0xb8, 0xd5,
-	0xbd, 0xd4, 0xb0, 0x88, 0xb7, 0x19, 0x3e, 0xf8, 0xb7, 0xce, 0xc0, 0xad, 0xc2, 0x97, 0x2f, 0x36,
-	0x68, 0xf3, 0xe9, 0x49, 0x83, 0x0b, 0x07, 0x5f, 0xab, 0x76, 0x20, 0x6a, 0x44, 0x51, 0x57, 0x4c,
-	0x7f, 0x37, 0xb9, 0xc3, 0xd5, 0x61, 0xbd, 0x3e, 0xa3, 0xa8, 0xab, 0x86, 0x6b, 0xb6, 0xff, 0x80,
-	0x71, 0xab, 0xd9, 0xd3, 0x46, 0xdc, 0x27, 0x60, 0x2a, 0xe5, 0xff, 0x73, 0x7c, 0xcd, 0x06, 0x01,
-	0x92, 0xaf, 0x4b, 0xf0, 0xa4, 0xcd, 0xdd, 0x3c, 0x9f, 0x3d, 0x83, 0xf7, 0x5c, 0x41, 0x1a, 0x54,
-	0xff, 0x14, 0x14, 0x75, 0x57, 0x43, 0xc9, 0xe2, 0xc3, 0x53, 0x9a, 0xbc, 0x8f, 0xc9, 0x32, 0x3e,
-	0xe0, 0x8b, 0x18, 0x90, 0x9b, 0x23, 0x5d, 0xdc, 0x73, 0x5a, 0xb6, 0x77, 0x7f, 0x03, 0x00, 0x00,
-	0xff, 0xff, 0xbe, 0xdc, 0xf4, 0x36, 0x5b, 0x01, 0x00, 0x00,
+	// 241 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x90, 0xcf, 0x4a, 0xc4, 0x30,
+	0x10, 0xc6, 0xa9, 0x28, 0x76, 0x23, 0xbb, 0x87, 0x9c, 0x6a, 0x51, 0xb6, 0x78, 0xea, 0x29, 0x85,
+	0x3d, 0xf6, 0x24, 0x2a, 0x7b, 0x5b, 0x84, 0x1e, 0xbc, 0x2e, 0x59, 0x33, 0xad, 0x03, 0xd1, 0x89,
+	0x99, 0xd4, 0x97, 0xf0, 0xa5, 0x65, 0xdb, 0x54, 0x2c, 0x8a, 0xb7, 0xcc, 0xc7, 0xef, 0xfb, 0x43,
+	0x84, 0x20, 0x17, 0x58, 0x39, 0x4f, 0x81, 0xe4, 0xe9, 0xf1, 0x9d, 0x17, 0x1d, 0x51, 0x67, 0xa1,
+	0x1a, 0xb4, 0x43, 0xdf, 0x56, 0x06, 0xf8, 0xd9, 0xa3, 0x0b, 0xe4, 0x47, 0x2e, 0x5f, 0x47, 0x42,
+	0x3b, 0xac, 0x5a, 0x04, 0x6b, 0xf6, 0x07, 0x78, 0xd1, 0x1f, 0x38, 0x01, 0x37, 0x9d, 0x38, 0x7f,
+	0x74, 0x01, 0xe9, 0x8d, 0x65, 0x2e, 0x52, 0x0f, 0xef, 0x3d, 0x7a, 0x30, 0x59, 0x52, 0x24, 0x65,
+	0xda, 0x7c, 0xdf, 0xf2, 0x56, 0xac, 0xe6, 0xf6, 0xec, 0xa4, 0x48, 0xca, 0xd5, 0xe6, 0x52, 0x8d,
+	0x05, 0x4a, 0x3b, 0x54, 0xdb, 0x23, 0x71, 0x17, 0x81, 0x66, 0xd9, 0xfe, 0x3c, 0xeb, 0x5a, 0x2c,
+	0x02, 0xef, 0x5f, 0xc9, 0xf4, 0x16, 0xe4, 0xd5, 0x64, 0x9b, 0x96, 0xab, 0x2d, 0x5a, 0x88, 0x43,
+	0xb2, 0xcf, 0xb4, 0x48, 0xca, 0x45, 0x93, 0x06, 0xde, 0x0d, 0x78, 0xfd, 0x34, 0xb5, 0x1b, 0x68,
+	0x75, 0x6f, 0x03, 0xcb, 0xf5, 0xaf, 0x80, 0x1d, 0x30, 0xeb, 0x6e, 0x9e, 0x71, 0xb1, 0x59, 0xaa,
+	0xe1, 0xd3, 0xa2, 0x1a, 0x37, 0x3d, 0xc4, 0x94, 0xfa, 0x5e, 0x9c, 0x0d, 0x82, 0xbc, 0xfe, 0x63,
+	0x0f, 0x58, 0xf3, 0x7f, 0xd8, 0xe8, 0xfd, 0x1a, 0x00, 0x84, 0xc9, 0x56, 0x1e, 0x97, 0x01, 0x00,
+	0x00,
 }
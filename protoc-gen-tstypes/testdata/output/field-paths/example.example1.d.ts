@@ -0,0 +1,61 @@
+// Code generated by protoc-gen-tstypes. DO NOT EDIT.
+
+declare namespace example {
+
+    export enum SearchRequest_Corpus {
+        UNIVERSAL = "UNIVERSAL",
+        WEB = "WEB",
+        IMAGES = "IMAGES",
+        LOCAL = "LOCAL",
+        NEWS = "NEWS",
+        PRODUCTS = "PRODUCTS",
+        VIDEO = "VIDEO",
+    }
+    export interface SearchRequest_XyzEntry {
+        key?: string;
+        value?: number;
+    }
+
+    export interface SearchRequest {
+        query?: string;
+        page_number?: number;
+        result_per_page?: number;
+        corpus?: SearchRequest_Corpus;
+        sent_at?: google.protobuf.Timestamp;
+        xyz?: { [key: string]: number };
+        zytes?: Uint8Array;
+    }
+
+    export type SearchRequestFieldPath =
+        | "query"
+        | "page_number"
+        | "result_per_page"
+        | "corpus"
+        | "sent_at"
+        | "sent_at.seconds"
+        | "sent_at.nanos"
+        | "xyz"
+        | "zytes";
+
+    export interface SearchResponse {
+        results?: Array<string>;
+        num_results?: number;
+        original_request?: SearchRequest;
+    }
+
+    export type SearchResponseFieldPath =
+        | "results"
+        | "num_results"
+        | "original_request"
+        | "original_request.query"
+        | "original_request.page_number"
+        | "original_request.result_per_page"
+        | "original_request.corpus"
+        | "original_request.sent_at"
+        | "original_request.sent_at.seconds"
+        | "original_request.sent_at.nanos"
+        | "original_request.xyz"
+        | "original_request.zytes";
+
+}
+
@@ -0,0 +1,36 @@
+// Code generated by protoc-gen-tstypes. DO NOT EDIT.
+
+declare namespace example {
+
+    export enum SearchRequest_Corpus {
+        UNIVERSAL = "UNIVERSAL",
+        WEB = "WEB",
+        IMAGES = "IMAGES",
+        LOCAL = "LOCAL",
+        NEWS = "NEWS",
+        PRODUCTS = "PRODUCTS",
+        VIDEO = "VIDEO",
+    }
+    export interface SearchRequest_XyzEntry {
+        readonly key?: string;
+        readonly value?: number;
+    }
+
+    export interface SearchRequest {
+        readonly query?: string;
+        readonly page_number?: number;
+        readonly result_per_page?: number;
+        readonly corpus?: SearchRequest_Corpus;
+        readonly sent_at?: google.protobuf.Timestamp;
+        readonly xyz?: Readonly<Record<string, number>>;
+        readonly zytes?: Uint8Array;
+    }
+
+    export interface SearchResponse {
+        readonly results?: ReadonlyArray<string>;
+        readonly num_results?: number;
+        readonly original_request?: SearchRequest;
+    }
+
+}
+
@@ -0,0 +1,40 @@
+// Code generated by protoc-gen-tstypes. DO NOT EDIT.
+
+declare namespace example_with_field_options {
+
+    export enum SearchRequest_Corpus {
+        UNIVERSAL = "UNIVERSAL",
+        WEB = "WEB",
+        IMAGES = "IMAGES",
+        LOCAL = "LOCAL",
+        NEWS = "NEWS",
+        PRODUCTS = "PRODUCTS",
+        VIDEO = "VIDEO",
+    }
+    export interface SearchRequest_XyzEntry {
+        readonly key?: string;
+        readonly value?: number;
+    }
+
+    // SearchRequest is an example type representing a search query.
+    export interface SearchRequest {
+        readonly query?: string;
+        readonly page_number?: number;
+        // Number of results per page.
+        readonly result_per_page?: number; // Should never be zero.
+        readonly corpus?: SearchRequest_Corpus;
+        readonly sent_at?: google.protobuf.Timestamp;
+        readonly xyz?: Readonly<Record<string, number>>;
+        readonly zytes?: Uint8Array;
+        readonly example_required: number;
+    }
+
+    export interface SearchResponse {
+        readonly results: ReadonlyArray<string>;
+        readonly num_results: number;
+        readonly original_request: SearchRequest;
+        readonly next_results_uri?: string;
+    }
+
+}
+
@@ -0,0 +1,26 @@
+// Code generated by protoc-gen-tstypes. DO NOT EDIT.
+
+declare namespace grpc.testing {
+
+    // Unary request.
+    export interface Request {
+        // Whether Response should include username.
+        readonly fill_username?: boolean;
+        // Whether Response should include OAuth scope.
+        readonly fill_oauth_scope?: boolean;
+    }
+
+    // Unary response, as configured by the request.
+    export interface Response {
+        // The user the request came from, for verifying authentication was
+        // successful.
+        readonly username?: string;
+        // OAuth scope.
+        readonly oauth_scope?: string;
+    }
+
+    export interface TestServiceService {
+        UnaryCall: (r:Request) => Response;
+    }
+}
+